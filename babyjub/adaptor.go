@@ -0,0 +1,116 @@
+package babyjub
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// ErrVerifyPreSignatureFailed BJJ adaptor pre-signature verification failed
+var ErrVerifyPreSignatureFailed = errors.New("verifyPreSignature failed")
+
+// adaptorNonceTag is hashed into the nonce of a pre-signature, together with
+// the adaptor point, so that the nonce never matches the one of a regular
+// signature of the same message.
+var adaptorNonceTag = []byte("babyjub-adaptor")
+
+// PreSignature is an EdDSA-Poseidon adaptor pre-signature.  It is created
+// under an adaptor point T = t * B8, and can be completed into a regular
+// Signature by anyone who knows t.  Once the completed signature is
+// published, t can be extracted from it and the pre-signature.
+//
+// R8 is the nonce point of the pre-signature, which does not include the
+// adaptor point; the completed signature uses R8 + T as its nonce point.
+type PreSignature struct {
+	R8 *Point
+	S  *big.Int
+}
+
+// addPoints returns p + q.
+func addPoints(p, q *Point) *Point {
+	return NewPointProjective().Add(p.Projective(), q.Projective()).Affine()
+}
+
+// PreSignPoseidon creates a pre-signature of a message encoded as a big.Int
+// in Zq under the adaptor point T:
+//
+//	R' = r * B8, R = R' + T
+//	hm = H1(R.x, R.y, A.x, A.y, msg)
+//	S' = r + hm * 8 * s
+//
+// The nonce r is derived deterministically from the private key, T and msg.
+// T must be a point of the subgroup other than the identity.
+func (k *PrivateKey) PreSignPoseidon(msg *big.Int, T *Point) (*PreSignature, error) {
+	if err := T.checkSubGroup(); err != nil {
+		return nil, fmt.Errorf("invalid adaptor point: %w", err)
+	}
+	tComp := T.Compress()
+	r, err := k.nonce(msg, nil, append(append([]byte{}, adaptorNonceTag...), tComp[:]...))
+	if err != nil {
+		return nil, err
+	}
+	R8p := NewPoint().MulScalar(r, B8) // R8' = r * 8 * B
+	R8 := addPoints(R8p, T)            // R8 = R8' + T
+	A := k.Public().Point()
+	hm, err := challengePoseidon(R8, A, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	S := signResponse(r, hm, k.Scalar()) // S' = r + hm * 8 * s
+
+	return &PreSignature{R8: R8p, S: S}, nil
+}
+
+// VerifyPreSignaturePoseidon verifies a pre-signature of a message encoded as
+// a big.Int in Zq under the adaptor point T, checking that
+// S' * B8 = R' + 8 * hm * A, with hm computed over R = R' + T.  A valid
+// pre-signature guarantees that adapting it with the discrete logarithm of
+// T gives a signature that passes VerifyPoseidon.
+func (pk *PublicKey) VerifyPreSignaturePoseidon(msg *big.Int, T *Point,
+	preSig *PreSignature) error {
+	if err := T.checkSubGroup(); err != nil {
+		return fmt.Errorf("invalid adaptor point: %w", err)
+	}
+	if !preSig.R8.InCurve() || !utils.CheckBigIntInField(preSig.S) {
+		return ErrVerifyPreSignatureFailed
+	}
+	R8 := addPoints(preSig.R8, T)
+	hm, err := challengePoseidon(R8, pk.Point(), msg)
+	if err != nil {
+		return err
+	}
+
+	// S' * B8 = R' + 8 * hm * A
+	if pk.verifyEquation(preSig.R8, preSig.S, hm) {
+		return nil
+	}
+	return ErrVerifyPreSignatureFailed
+}
+
+// Adapt completes the pre-signature with the adaptor secret t, where the
+// adaptor point is T = t * B8, returning a Signature that verifies with
+// VerifyPoseidon:
+//
+//	R = R' + T, S = S' + t
+func (preSig *PreSignature) Adapt(t *big.Int) *Signature {
+	T := NewPoint().Mul(t, B8)
+	S := new(big.Int).Add(preSig.S, t)
+	S.Mod(S, SubOrder)
+	return &Signature{R8: addPoints(preSig.R8, T), S: S}
+}
+
+// Extract returns the adaptor secret t from the pre-signature and the
+// Signature obtained by adapting it, t = S - S'.  Returns error if sig is not
+// the adaptation of the pre-signature.
+func (preSig *PreSignature) Extract(sig *Signature) (*big.Int, error) {
+	t := new(big.Int).Sub(sig.S, preSig.S)
+	t.Mod(t, SubOrder)
+	R8 := addPoints(preSig.R8, NewPoint().Mul(t, B8))
+	if R8.X.Cmp(sig.R8.X) != 0 || R8.Y.Cmp(sig.R8.Y) != 0 {
+		return nil, errors.New("signature is not an adaptation of the pre-signature")
+	}
+	return t, nil
+}
@@ -0,0 +1,64 @@
+package babyjub
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptorSignature(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+	msg := big.NewInt(123456789)
+
+	secret, _ := new(big.Int).SetString(
+		"1234567890123456789012345678901234567890123456789012345678", 10)
+	T := NewPoint().Mul(secret, B8)
+
+	preSig, err := k.PreSignPoseidon(msg, T)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyPreSignaturePoseidon(msg, T, preSig))
+
+	// A pre-signature is not a valid signature
+	assert.Equal(t, ErrVerifyPoseidonFailed,
+		pk.VerifyPoseidon(msg, &Signature{R8: preSig.R8, S: preSig.S}))
+
+	// Wrong adaptor point, message or public key
+	T2 := NewPoint().Mul(big.NewInt(2), T)
+	assert.Equal(t, ErrVerifyPreSignatureFailed, pk.VerifyPreSignaturePoseidon(msg, T2, preSig))
+	assert.Equal(t, ErrVerifyPreSignatureFailed,
+		pk.VerifyPreSignaturePoseidon(big.NewInt(1), T, preSig))
+	assert.Equal(t, ErrVerifyPreSignatureFailed,
+		(&PrivateKey{}).Public().VerifyPreSignaturePoseidon(msg, T, preSig))
+	assert.ErrorIs(t, pk.VerifyPreSignaturePoseidon(msg, NewPoint(), preSig), ErrIdentity)
+
+	sig := preSig.Adapt(secret)
+	require.NoError(t, pk.VerifyPoseidon(msg, sig))
+
+	extracted, err := preSig.Extract(sig)
+	require.NoError(t, err)
+	assert.Equal(t, secret, extracted)
+
+	// A signature from another pre-signature does not reveal the secret
+	preSig2, err := k.PreSignPoseidon(msg, T2)
+	require.NoError(t, err)
+	assert.NotEqual(t, preSig.R8, preSig2.R8)
+	sig2 := preSig2.Adapt(new(big.Int).Lsh(secret, 1))
+	require.NoError(t, pk.VerifyPoseidon(msg, sig2))
+	_, err = preSig.Extract(sig2)
+	assert.Error(t, err)
+
+	// The regular signature of the message uses a different nonce
+	sig3, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+	assert.NotEqual(t, sig3.R8, preSig.R8)
+
+	_, err = k.PreSignPoseidon(msg, NewPoint())
+	assert.ErrorIs(t, err, ErrIdentity)
+}
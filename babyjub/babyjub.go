@@ -1,14 +1,32 @@
 package babyjub
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
 	"github.com/iden3/go-iden3-crypto/v2/constants"
 	"github.com/iden3/go-iden3-crypto/v2/ff"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
 )
 
+var (
+	// ErrNotOnCurve is returned when a point is not in the babyjub curve,
+	// or when a compressed point does not correspond to any curve point.
+	ErrNotOnCurve = errors.New("point not on curve")
+	// ErrNotInSubgroup is returned when a point is in the curve but not in
+	// the prime order subgroup generated by B8.
+	ErrNotInSubgroup = errors.New("point not in subgroup")
+	// ErrIdentity is returned when the identity point is used where it is not
+	// allowed, like in a public key.
+	ErrIdentity = errors.New("point is the identity")
+	// ErrNonCanonical is returned when an encoding is not the canonical
+	// encoding of the value, like a coordinate that is not lower than Q.
+	ErrNonCanonical = errors.New("non-canonical encoding")
+)
+
 // A is one of the babyjub constants.
 var A *big.Int
 
@@ -85,6 +103,32 @@ func (p *PointProjective) Affine() *Point {
 	}
 }
 
+// BatchAffine returns the Points from the projective representations ps.  It
+// is equivalent to calling Affine on each of the points, but uses a single
+// field inversion for the whole slice.
+func BatchAffine(ps []*PointProjective) []*Point {
+	zs := make([]ff.Element, len(ps))
+	for i := range ps {
+		zs[i] = *ps[i].Z
+	}
+	zinvs := ff.BatchInvert(zs)
+
+	res := make([]*Point, len(ps))
+	x := ff.NewElement()
+	y := ff.NewElement()
+	for i := range ps {
+		// BatchInvert leaves zeros untouched, so a point with Z = 0 is
+		// converted to (0, 0) as in Affine.
+		x.Mul(ps[i].X, &zinvs[i])
+		y.Mul(ps[i].Y, &zinvs[i])
+		res[i] = &Point{
+			X: x.ToBigIntRegular(new(big.Int)),
+			Y: y.ToBigIntRegular(new(big.Int)),
+		}
+	}
+	return res
+}
+
 // Add computes the addition of two points in projective coordinates
 // representation
 func (p *PointProjective) Add(q, o *PointProjective) *PointProjective {
@@ -117,6 +161,189 @@ func (p *PointProjective) Add(q, o *PointProjective) *PointProjective {
 	return p
 }
 
+// Double computes the doubling of a point in projective coordinates
+// representation, which is faster than adding the point to itself.
+func (p *PointProjective) Double(q *PointProjective) *PointProjective {
+	// dbl-2008-bbjlp
+	// https://hyperelliptic.org/EFD/g1p/auto-twisted-projective.html#doubling-dbl-2008-bbjlp
+	b := ff.NewElement().Add(q.X, q.Y)
+	b.Square(b)
+	c := ff.NewElement().Square(q.X)
+	d := ff.NewElement().Square(q.Y)
+	e := ff.NewElement().Mul(Aff, c)
+	f := ff.NewElement().Add(e, d)
+	h := ff.NewElement().Square(q.Z)
+	j := ff.NewElement().Double(h)
+	j.Sub(f, j)
+	x3 := ff.NewElement().Sub(b, c)
+	x3.Sub(x3, d)
+	x3.Mul(x3, j)
+	y3 := ff.NewElement().Sub(e, d)
+	y3.Mul(y3, f)
+	z3 := ff.NewElement().Mul(f, j)
+
+	p.X = x3
+	p.Y = y3
+	p.Z = z3
+	return p
+}
+
+// Neg computes the negation of a point in projective coordinates
+// representation.
+func (p *PointProjective) Neg(q *PointProjective) *PointProjective {
+	p.X = ff.NewElement().Neg(q.X)
+	p.Y = ff.NewElement().Set(q.Y)
+	p.Z = ff.NewElement().Set(q.Z)
+	return p
+}
+
+// EqualAffine returns true when the point in projective coordinates p is the
+// affine Point q, comparing X = q.X * Z and Y = q.Y * Z without inverting Z.
+func (p *PointProjective) EqualAffine(q *Point) bool {
+	if p.Z.IsZero() {
+		return false
+	}
+	t := ff.NewElement().SetBigInt(q.X)
+	t.Mul(t, p.Z)
+	if !t.Equal(p.X) {
+		return false
+	}
+	t.SetBigInt(q.Y)
+	t.Mul(t, p.Z)
+	return t.Equal(p.Y)
+}
+
+// mulWindow is the width in bits of the windows used in DoubleMul.
+const mulWindow = 4
+
+// pointTable holds the multiples 0*P, 1*P, ..., 15*P of a point P.
+type pointTable [1 << mulWindow]*PointProjective
+
+// newPointTable returns the table of multiples of q.
+func newPointTable(q *PointProjective) *pointTable {
+	var t pointTable
+	t[0] = NewPointProjective()
+	t[1] = q
+	for i := 2; i < len(t); i++ {
+		t[i] = NewPointProjective().Add(t[i-1], q)
+	}
+	return &t
+}
+
+// newNegPointTable returns the table of multiples of -q.
+func newNegPointTable(q *Point) *pointTable {
+	return newPointTable(NewPointProjective().Neg(q.Projective()))
+}
+
+// neg returns the table of multiples of -P from the table of multiples of P.
+func (t *pointTable) neg() *pointTable {
+	var n pointTable
+	for i := range t {
+		n[i] = NewPointProjective().Neg(t[i])
+	}
+	return &n
+}
+
+// b8Table is the table of multiples of B8.
+var b8Table *pointTable
+
+func init() {
+	b8Table = newPointTable(B8.Projective())
+}
+
+// fixedBaseTable holds, for each window i, the multiples d * 16^i * P, for
+// d in 0..15, of a fixed point P.  It allows to compute k * P with one
+// addition per window and no doublings.
+type fixedBaseTable []pointTable
+
+// newFixedBaseTable returns the fixedBaseTable of q for scalars of up to bits
+// bits.
+func newFixedBaseTable(q *PointProjective, bits int) fixedBaseTable {
+	t := make(fixedBaseTable, (bits+mulWindow-1)/mulWindow)
+	base := q
+	for i := range t {
+		t[i] = *newPointTable(base)
+		base = NewPointProjective().Double(t[i][len(t[i])/2]) // 16 * base
+	}
+	return t
+}
+
+// mul computes k * P.  k must be non negative and lower than 16^len(t).
+func (t fixedBaseTable) mul(k *big.Int) *PointProjective {
+	res := NewPointProjective()
+	for i := range t {
+		if d := window(k, i); d != 0 {
+			res.Add(res, t[i][d])
+		}
+	}
+	return res
+}
+
+var (
+	b8FixedBaseTable     fixedBaseTable
+	b8FixedBaseTableOnce sync.Once
+)
+
+// getB8FixedBaseTable returns the fixedBaseTable of B8 for scalars lower
+// than SubOrder, computing it on first use.
+func getB8FixedBaseTable() fixedBaseTable {
+	b8FixedBaseTableOnce.Do(func() {
+		b8FixedBaseTable = newFixedBaseTable(B8.Projective(), SubOrder.BitLen())
+	})
+	return b8FixedBaseTable
+}
+
+// window returns the value of the i-th window of mulWindow bits of k.
+func window(k *big.Int, i int) uint {
+	var d uint
+	for j := mulWindow - 1; j >= 0; j-- {
+		d = d<<1 | k.Bit(i*mulWindow+j)
+	}
+	return d
+}
+
+// doubleMul computes s1 * P1 + s2 * P2 from the tables of multiples of P1 and
+// P2, using Straus' trick: both scalars are processed together, window by
+// window, sharing the doublings.
+func doubleMul(s1 *big.Int, t1 *pointTable, s2 *big.Int, t2 *pointTable) *PointProjective {
+	if s1.Sign() < 0 {
+		t1 = t1.neg()
+	}
+	if s2.Sign() < 0 {
+		t2 = t2.neg()
+	}
+	k1 := new(big.Int).Abs(s1)
+	k2 := new(big.Int).Abs(s2)
+	n := k1.BitLen()
+	if k2.BitLen() > n {
+		n = k2.BitLen()
+	}
+
+	res := NewPointProjective()
+	for i := (n+mulWindow-1)/mulWindow - 1; i >= 0; i-- {
+		for j := 0; j < mulWindow; j++ {
+			res.Double(res)
+		}
+		if d := window(k1, i); d != 0 {
+			res.Add(res, t1[d])
+		}
+		if d := window(k2, i); d != 0 {
+			res.Add(res, t2[d])
+		}
+	}
+	return res
+}
+
+// DoubleMul computes s1 * q1 + s2 * q2 and stores the result in p, which is
+// also returned.  It is faster than computing both products separately.
+// Negative scalars multiply the negated point.
+func (p *PointProjective) DoubleMul(s1 *big.Int, q1 *PointProjective,
+	s2 *big.Int, q2 *PointProjective) *PointProjective {
+	res := doubleMul(s1, newPointTable(q1), s2, newPointTable(q2))
+	p.X, p.Y, p.Z = res.X, res.Y, res.Z
+	return p
+}
+
 // Point represents a point of the babyjub curve.
 type Point struct {
 	X *big.Int
@@ -145,7 +372,9 @@ func (p *Point) Projective() *PointProjective {
 }
 
 // Mul multiplies the Point q by the scalar s and stores the result in p,
-// which is also returned.
+// which is also returned.  Negative scalars multiply by -q.  The scalar is
+// not reduced modulo SubOrder, so that Mul can be used with points outside
+// of the subgroup; use MulScalar for scalars of the subgroup order.
 func (p *Point) Mul(s *big.Int, q *Point) *Point {
 	resProj := &PointProjective{
 		X: ff.NewElement().SetZero(),
@@ -153,9 +382,14 @@ func (p *Point) Mul(s *big.Int, q *Point) *Point {
 		Z: ff.NewElement().SetOne(),
 	}
 	exp := q.Projective()
+	k := s
+	if s.Sign() < 0 {
+		k = new(big.Int).Neg(s)
+		exp.Neg(exp)
+	}
 
-	for i := 0; i < s.BitLen(); i++ {
-		if s.Bit(i) == 1 {
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
 			resProj.Add(resProj, exp)
 		}
 		exp = exp.Add(exp, exp)
@@ -164,6 +398,13 @@ func (p *Point) Mul(s *big.Int, q *Point) *Point {
 	return p
 }
 
+// MulScalar multiplies the Point q by the scalar s and stores the result in
+// p, which is also returned.  Since s is an integer modulo SubOrder, q should
+// be a point of the subgroup.
+func (p *Point) MulScalar(s *scalar.Element, q *Point) *Point {
+	return p.Mul(s.BigInt(), q)
+}
+
 // InCurve returns true when the Point p is in the babyjub curve.
 func (p *Point) InCurve() bool {
 	x2 := new(big.Int).Set(p.X)
@@ -238,21 +479,49 @@ func (p *Point) Compress() [32]byte {
 	return PackSignY(sign, p.Y)
 }
 
+// BatchCompress compresses each of the points ps.
+func BatchCompress(ps []*Point) [][32]byte {
+	res := make([][32]byte, len(ps))
+	for i := range ps {
+		res[i] = ps[i].Compress()
+	}
+	return res
+}
+
+// BatchCompressProjective compresses each of the points ps given in projective
+// representation, using a single field inversion for the whole slice.
+func BatchCompressProjective(ps []*PointProjective) [][32]byte {
+	return BatchCompress(BatchAffine(ps))
+}
+
 // Decompress a compressed Point into p, and also returns the decompressed
-// Point.  Returns error if the compressed Point is invalid.
+// Point.  Returns error if the compressed Point is invalid.  The
+// decompressed Point may be outside of the subgroup, use DecompressChecked to
+// also enforce subgroup membership.
 func (p *Point) Decompress(leBuf [32]byte) (*Point, error) {
 	var sign bool
 	sign, p.Y = UnpackSignY(leBuf)
 	return PointFromSignAndY(sign, p.Y)
 }
 
-// PointFromSignAndY returns a Point from a Sign and the Y coordinate
+// DecompressChecked decompresses a compressed Point like Decompress, but
+// also rejects non-canonical encodings, points outside of the subgroup, and
+// the identity.  Errors wrap ErrNonCanonical, ErrNotOnCurve,
+// ErrNotInSubgroup or ErrIdentity.
+func (p *Point) DecompressChecked(leBuf [32]byte) (*Point, error) {
+	var sign bool
+	sign, p.Y = UnpackSignY(leBuf)
+	return PointFromSignAndYChecked(sign, p.Y)
+}
+
+// PointFromSignAndY returns a Point from a Sign and the Y coordinate.  Errors
+// wrap ErrNonCanonical or ErrNotOnCurve.
 func PointFromSignAndY(sign bool, y *big.Int) (*Point, error) {
 	var p Point
 	p.X = big.NewInt(0)
 	p.Y = y
 	if p.Y.Cmp(constants.Q) >= 0 {
-		return nil, fmt.Errorf("p.y >= Q")
+		return nil, fmt.Errorf("%w: p.y >= Q", ErrNonCanonical)
 	}
 
 	y2 := new(big.Int).Mul(p.Y, p.Y)
@@ -265,14 +534,14 @@ func PointFromSignAndY(sign bool, y *big.Int) (*Point, error) {
 	xb.Sub(A, xb) // xb = A - d * y^2
 
 	if xb.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf("division by 0")
+		return nil, fmt.Errorf("%w: division by 0", ErrNotOnCurve)
 	}
 	xb.ModInverse(xb, constants.Q)
 	p.X.Mul(xa, xb) // xa / xb
 	p.X.Mod(p.X, constants.Q)
 	noSqrt := p.X.ModSqrt(p.X, constants.Q)
 	if noSqrt == nil {
-		return nil, fmt.Errorf("x is not a square mod q")
+		return nil, fmt.Errorf("%w: x is not a square mod q", ErrNotOnCurve)
 	}
 	if (sign && !PointCoordSign(p.X)) || (!sign && PointCoordSign(p.X)) {
 		p.X.Mul(p.X, constants.MinusOne)
@@ -281,3 +550,40 @@ func PointFromSignAndY(sign bool, y *big.Int) (*Point, error) {
 
 	return &p, nil
 }
+
+// PointFromSignAndYChecked returns a Point from a Sign and the Y coordinate
+// like PointFromSignAndY, but also rejects a sign set for x = 0, points
+// outside of the subgroup, and the identity.  Errors wrap ErrNonCanonical,
+// ErrNotOnCurve, ErrNotInSubgroup or ErrIdentity.
+func PointFromSignAndYChecked(sign bool, y *big.Int) (*Point, error) {
+	p, err := PointFromSignAndY(sign, y)
+	if err != nil {
+		return nil, err
+	}
+	if sign && p.X.Sign() == 0 {
+		return nil, fmt.Errorf("%w: sign set for x = 0", ErrNonCanonical)
+	}
+	if err := p.checkSubGroup(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// checkSubGroup returns nil when the Point p is in the subgroup of the babyjub
+// curve and is not the identity.  Otherwise it returns ErrNotOnCurve,
+// ErrNotInSubgroup or ErrIdentity.
+func (p *Point) checkSubGroup() error {
+	if !utils.CheckBigIntInField(p.X) || !utils.CheckBigIntInField(p.Y) {
+		return fmt.Errorf("%w: coordinate not inside Finite Field", ErrNonCanonical)
+	}
+	if !p.InCurve() {
+		return ErrNotOnCurve
+	}
+	if p.X.Sign() == 0 && p.Y.Cmp(constants.One) == 0 {
+		return ErrIdentity
+	}
+	if !p.InSubGroup() {
+		return ErrNotInSubgroup
+	}
+	return nil
+}
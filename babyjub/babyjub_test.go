@@ -6,9 +6,12 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
 	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/iden3/go-iden3-crypto/v2/ff"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAdd1(t *testing.T) {
@@ -168,6 +171,33 @@ func TestMul2(t *testing.T) {
 		r.Y.String())
 }
 
+func TestMulNeg(t *testing.T) {
+	s := big.NewInt(12345)
+	r := NewPoint().Mul(new(big.Int).Neg(s), B8)
+	expected := NewPoint().Mul(s, B8)
+	expected.X.Sub(constants.Q, expected.X)
+	assert.Equal(t, expected, r)
+
+	// s * B8 + (-s) * B8 = 0
+	sum := addPoints(r, NewPoint().Mul(s, B8))
+	assert.Equal(t, "0", sum.X.String())
+	assert.Equal(t, "1", sum.Y.String())
+}
+
+func TestMulScalar(t *testing.T) {
+	s, _ := utils.NewIntFromString(
+		"20819045374670962167435360035096875258406992893633759881276124905556507972311")
+	e := scalar.NewElement().SetBigInt(s)
+	r := NewPoint().MulScalar(e, B8)
+	// B8 has order SubOrder, so s and s mod SubOrder give the same point
+	assert.Equal(t, NewPoint().Mul(s, B8), r)
+
+	// -s as a scalar is SubOrder - s
+	e.Neg(e)
+	assert.Equal(t, NewPoint().Mul(new(big.Int).Neg(s), B8),
+		NewPoint().MulScalar(e, B8))
+}
+
 func TestInCurve3(t *testing.T) {
 	x, _ := utils.NewIntFromString(
 		"17777552123799933955779906779655732241715742912184938656739573121738514868268")
@@ -285,6 +315,172 @@ func TestCompressDecompressRnd(t *testing.T) {
 	}
 }
 
+func TestDecompressChecked(t *testing.T) {
+	p1 := NewPoint().Mul(big.NewInt(12345), B8)
+	p2, err := NewPoint().DecompressChecked(p1.Compress())
+	require.NoError(t, err)
+	assert.Equal(t, p1, p2)
+
+	// (-x, -y) is the sum of (x, y) and the point of order 2 (0, -1), so it
+	// is in the curve but not in the subgroup.
+	q := &Point{
+		X: new(big.Int).Sub(constants.Q, p1.X),
+		Y: new(big.Int).Sub(constants.Q, p1.Y),
+	}
+	require.True(t, q.InCurve())
+	_, err = NewPoint().Decompress(q.Compress())
+	require.NoError(t, err)
+	_, err = NewPoint().DecompressChecked(q.Compress())
+	assert.ErrorIs(t, err, ErrNotInSubgroup)
+
+	// Identity
+	_, err = NewPoint().DecompressChecked(NewPoint().Compress())
+	assert.ErrorIs(t, err, ErrIdentity)
+
+	// y >= Q
+	buf := PackSignY(false, new(big.Int).Add(constants.Q, p1.Y))
+	_, err = NewPoint().Decompress(buf)
+	assert.ErrorIs(t, err, ErrNonCanonical)
+	_, err = NewPoint().DecompressChecked(buf)
+	assert.ErrorIs(t, err, ErrNonCanonical)
+
+	// Sign set for x = 0.  (0, -1) is also out of the subgroup, but the
+	// encoding is rejected first.
+	buf = PackSignY(true, new(big.Int).Sub(constants.Q, constants.One))
+	_, err = NewPoint().DecompressChecked(buf)
+	assert.ErrorIs(t, err, ErrNonCanonical)
+
+	// y with no corresponding x
+	y := big.NewInt(2)
+	for ; ; y.Add(y, constants.One) {
+		if _, err = PointFromSignAndY(false, y); err != nil {
+			break
+		}
+	}
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+	_, err = PointFromSignAndYChecked(false, y)
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+}
+
+func TestPublicKeyParseChecked(t *testing.T) {
+	k := PrivateKey{}
+	pk := k.Public()
+	require.NoError(t, pk.Validate())
+
+	q := &Point{
+		X: new(big.Int).Sub(constants.Q, pk.X),
+		Y: new(big.Int).Sub(constants.Q, pk.Y),
+	}
+	qPk := (*PublicKey)(q)
+	assert.ErrorIs(t, qPk.Validate(), ErrNotInSubgroup)
+	qComp := qPk.Compress()
+	_, err := qComp.Decompress()
+	assert.ErrorIs(t, err, ErrNotInSubgroup)
+
+	text, err := qPk.MarshalText()
+	require.NoError(t, err)
+	var pk2 PublicKey
+	assert.ErrorIs(t, pk2.UnmarshalText(text), ErrNotInSubgroup)
+	assert.ErrorIs(t, pk2.Scan(qComp[:]), ErrNotInSubgroup)
+
+	identity := (*PublicKey)(NewPoint())
+	assert.ErrorIs(t, identity.Validate(), ErrIdentity)
+	idComp := identity.Compress()
+	assert.ErrorIs(t, pk2.Scan(idComp[:]), ErrIdentity)
+}
+
+func TestBatchAffine(t *testing.T) {
+	ps := make([]*PointProjective, 16)
+	acc := B8.Projective()
+	for i := range ps {
+		acc = NewPointProjective().Add(acc, B8.Projective())
+		ps[i] = acc
+	}
+	ps[5] = &PointProjective{
+		X: ff.NewElement().SetOne(),
+		Y: ff.NewElement().SetOne(),
+		Z: ff.NewElement().SetZero(),
+	}
+	affine := BatchAffine(ps)
+	require.Equal(t, len(ps), len(affine))
+	for i := range ps {
+		expected := ps[i].Affine()
+		assert.Equal(t, expected.X.String(), affine[i].X.String())
+		assert.Equal(t, expected.Y.String(), affine[i].Y.String())
+	}
+
+	comp := BatchCompressProjective(ps)
+	for i := range ps {
+		assert.Equal(t, ps[i].Affine().Compress(), comp[i])
+	}
+	assert.Equal(t, comp, BatchCompress(affine))
+
+	pks := make([]*PublicKey, 4)
+	for i := range pks {
+		pks[i] = (*PublicKey)(affine[i])
+	}
+	pksComp := BatchCompressPublicKeys(pks)
+	for i := range pks {
+		assert.Equal(t, pks[i].Compress(), pksComp[i])
+	}
+
+	assert.Empty(t, BatchAffine(nil))
+}
+
+func TestDoubleAndNeg(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		p := NewPoint().Mul(big.NewInt(int64(i*i+3)), B8).Projective()
+		assert.Equal(t, NewPointProjective().Add(p, p).Affine(),
+			NewPointProjective().Double(p).Affine())
+		sum := NewPointProjective().Add(p, NewPointProjective().Neg(p)).Affine()
+		assert.Equal(t, "0", sum.X.String())
+		assert.Equal(t, "1", sum.Y.String())
+	}
+	// Points out of the subgroup
+	q := &Point{
+		X: new(big.Int).Sub(constants.Q, B8.X),
+		Y: new(big.Int).Sub(constants.Q, B8.Y),
+	}
+	assert.Equal(t, NewPointProjective().Add(q.Projective(), q.Projective()).Affine(),
+		NewPointProjective().Double(q.Projective()).Affine())
+}
+
+func TestDoubleMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42)) //nolint:gosec
+	q := &Point{
+		X: new(big.Int).Sub(constants.Q, B8.X),
+		Y: new(big.Int).Sub(constants.Q, B8.Y),
+	}
+	for i := 0; i < 16; i++ {
+		s1 := new(big.Int).Rand(rnd, constants.Q)
+		s2 := new(big.Int).Rand(rnd, constants.Q)
+		p1 := NewPoint().Mul(big.NewInt(int64(i+1)), B8)
+		p2 := q
+		if i%2 == 0 {
+			p2 = NewPoint().Mul(big.NewInt(int64(i+7)), B8)
+		}
+		expected := NewPointProjective().Add(
+			NewPoint().Mul(s1, p1).Projective(),
+			NewPoint().Mul(s2, p2).Projective()).Affine()
+		res := NewPointProjective().DoubleMul(s1, p1.Projective(), s2, p2.Projective())
+		assert.Equal(t, expected, res.Affine())
+		assert.True(t, res.EqualAffine(expected))
+		assert.False(t, res.EqualAffine(p1))
+	}
+
+	// Negative scalars
+	s1 := big.NewInt(-12345)
+	s2 := big.NewInt(678)
+	res := NewPointProjective().DoubleMul(s1, B8.Projective(), s2, B8.Projective())
+	expected := NewPoint().Mul(new(big.Int).Add(SubOrder, big.NewInt(678-12345)), B8)
+	assert.Equal(t, expected, res.Affine())
+
+	// Zero scalars
+	res = NewPointProjective().DoubleMul(big.NewInt(0), B8.Projective(),
+		big.NewInt(0), B8.Projective())
+	assert.True(t, res.EqualAffine(NewPoint()))
+}
+
 func BenchmarkBabyjub(b *testing.B) {
 	const n = 256
 
@@ -341,6 +537,32 @@ func BenchmarkBabyjub(b *testing.B) {
 		}
 	})
 
+	var pointsProjZ [n]*PointProjective
+	for i := 0; i < n; i++ {
+		pointsProjZ[i] = NewPointProjective().Add(pointsProj[i], pointsProj[(i+1)%n])
+	}
+
+	b.Run("Affine", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				pointsProjZ[j].Affine()
+			}
+		}
+	})
+
+	b.Run("BatchAffine", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BatchAffine(pointsProjZ[:])
+		}
+	})
+
+	b.Run("DoubleMulRnd", func(b *testing.B) {
+		res := NewPointProjective()
+		for i := 0; i < b.N; i++ {
+			res.DoubleMul(scalars[i%n], pointsProj[i%n], scalars[(i+1)%n], pointsProj[(i+1)%n])
+		}
+	})
+
 	b.Run("Compress", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			points[i%n].Compress()
@@ -0,0 +1,153 @@
+package babyjub
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
+)
+
+// Blind EdDSA-Poseidon signatures, following the blind Schnorr scheme.  The
+// issuer signs a message without learning it, and the result is a regular
+// Signature that verifies with PublicKey.VerifyPoseidon.
+//
+// The protocol runs in three moves between the issuer, holding the
+// PrivateKey, and the user, holding the message:
+//
+//  1. The issuer creates a BlindSignerSession and sends its commitment
+//     R' = k * B8 to the user.
+//  2. The user creates a Blinder for the message, which picks random a and b
+//     and computes R = R' + a * B8 + b * 8 * A and the blinded challenge
+//     e = H1(R.x, R.y, A.x, A.y, msg) + b, and sends e to the issuer.
+//  3. The issuer answers with S' = k + e * 8 * s, and the user unblinds the
+//     signature (R, S) with S = S' + a.
+//
+// Each issuer session must only be used once.  As with any blind Schnorr
+// scheme, an issuer that keeps many sessions open concurrently is exposed to
+// the ROS attack, which lets users obtain one more signature than sessions
+// completed.  Issuers should bound the number of concurrent open sessions.
+
+var (
+	// ErrBlindSessionUsed is returned when a BlindSignerSession is used to
+	// sign more than once.
+	ErrBlindSessionUsed = errors.New("blind signer session already used")
+	// ErrUnblindFailed is returned when the issuer response does not yield a
+	// valid signature.
+	ErrUnblindFailed = errors.New("unblinded signature verification failed")
+)
+
+// blindNonceTag is hashed into the nonce of a blind signer session.
+var blindNonceTag = []byte("babyjub-blind")
+
+// randScalar returns a uniformly random scalar in [0, SubOrder) read from
+// rnd.  If rnd is nil, crypto/rand.Reader is used.
+func randScalar(rnd io.Reader) (*big.Int, error) {
+	s, err := scalar.NewElement().SetRandomFrom(rnd)
+	if err != nil {
+		return nil, err
+	}
+	return s.BigInt(), nil
+}
+
+// BlindSignerSession is the issuer side of a blind signature.
+type BlindSignerSession struct {
+	k  *PrivateKey
+	r  *scalar.Element
+	R8 *Point
+}
+
+// NewBlindSignerSession starts a new blind signature session.  The nonce of
+// the session is derived from the private key and 32 bytes of randomness
+// read from rnd.  If rnd is nil, crypto/rand.Reader is used.
+func (k *PrivateKey) NewBlindSignerSession(rnd io.Reader) (*BlindSignerSession, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	r, err := k.nonce(big.NewInt(0), rnd, blindNonceTag)
+	if err != nil {
+		return nil, err
+	}
+	return &BlindSignerSession{k: k, r: r, R8: NewPoint().MulScalar(r, B8)}, nil
+}
+
+// Commitment returns the commitment R' = r * B8 that is sent to the user.
+func (s *BlindSignerSession) Commitment() *Point {
+	return s.R8
+}
+
+// Sign answers the blinded challenge e received from the user with
+// S' = r + e * 8 * s.  A session can only be used to sign once; further calls
+// return ErrBlindSessionUsed.
+func (s *BlindSignerSession) Sign(e *big.Int) (*big.Int, error) {
+	if s.r == nil {
+		return nil, ErrBlindSessionUsed
+	}
+	if e.Sign() < 0 || e.Cmp(SubOrder) >= 0 {
+		return nil, errors.New("blinded challenge not inside the subgroup order")
+	}
+	S := signResponse(s.r, e, s.k.Scalar()) // S' = r + e * 8 * s
+	s.r = nil
+	return S, nil
+}
+
+// Blinder is the user side of a blind signature.
+type Blinder struct {
+	pk  *PublicKey
+	msg *big.Int
+	a   *big.Int
+	R8  *Point
+	e   *big.Int
+}
+
+// NewBlinder blinds the message msg, encoded as a big.Int in Zq, for the
+// issuer public key pk and the issuer commitment R8.  The blinding factors
+// are read from rnd.  If rnd is nil, crypto/rand.Reader is used.
+func NewBlinder(pk *PublicKey, commitment *Point, msg *big.Int,
+	rnd io.Reader) (*Blinder, error) {
+	if err := pk.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if err := commitment.checkSubGroup(); err != nil {
+		return nil, fmt.Errorf("invalid commitment: %w", err)
+	}
+	a, err := randScalar(rnd)
+	if err != nil {
+		return nil, err
+	}
+	b, err := randScalar(rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// R = R' + a * B8 + b * 8 * A
+	bA := NewPoint().Mul(new(big.Int).Lsh(b, 3), pk.Point())
+	R8 := addPoints(addPoints(commitment, NewPoint().Mul(a, B8)), bA)
+	hm, err := challengePoseidon(R8, pk.Point(), msg)
+	if err != nil {
+		return nil, err
+	}
+	e := new(big.Int).Add(hm, b)
+	e.Mod(e, SubOrder) // e = hm + b
+	return &Blinder{pk: pk, msg: msg, a: a, R8: R8, e: e}, nil
+}
+
+// Challenge returns the blinded challenge e that is sent to the issuer.
+func (b *Blinder) Challenge() *big.Int {
+	return b.e
+}
+
+// Unblind computes the signature of the message from the issuer response
+// S', with S = S' + a.  Returns ErrUnblindFailed if the resulting signature
+// does not verify.
+func (b *Blinder) Unblind(blindS *big.Int) (*Signature, error) {
+	S := new(big.Int).Add(blindS, b.a)
+	S.Mod(S, SubOrder)
+	sig := &Signature{R8: b.R8, S: S}
+	if err := b.pk.VerifyPoseidon(b.msg, sig); err != nil {
+		return nil, ErrUnblindFailed
+	}
+	return sig, nil
+}
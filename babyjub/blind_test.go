@@ -0,0 +1,63 @@
+package babyjub
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindSignature(t *testing.T) {
+	k, err := NewRandPrivKey()
+	require.NoError(t, err)
+	pk := k.Public()
+	msg := big.NewInt(42)
+
+	// Issuer
+	session, err := k.NewBlindSignerSession(nil)
+	require.NoError(t, err)
+	// User
+	blinder, err := NewBlinder(pk, session.Commitment(), msg, nil)
+	require.NoError(t, err)
+	// Issuer
+	blindS, err := session.Sign(blinder.Challenge())
+	require.NoError(t, err)
+	// User
+	sig, err := blinder.Unblind(blindS)
+	require.NoError(t, err)
+	// Verifier
+	require.NoError(t, pk.VerifyPoseidon(msg, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(big.NewInt(43), sig))
+
+	// The issuer does not see the nonce point or the challenge of the final
+	// signature.
+	assert.NotEqual(t, session.Commitment(), sig.R8)
+	hm, err := challengePoseidon(sig.R8, pk.Point(), msg)
+	require.NoError(t, err)
+	assert.NotEqual(t, new(big.Int).Mod(hm, SubOrder), blinder.Challenge())
+
+	// Sessions are single use
+	_, err = session.Sign(blinder.Challenge())
+	assert.Equal(t, ErrBlindSessionUsed, err)
+
+	// A wrong response is detected when unblinding
+	session, err = k.NewBlindSignerSession(nil)
+	require.NoError(t, err)
+	blinder, err = NewBlinder(pk, session.Commitment(), msg, nil)
+	require.NoError(t, err)
+	blindS, err = session.Sign(blinder.Challenge())
+	require.NoError(t, err)
+	_, err = blinder.Unblind(new(big.Int).Add(blindS, big.NewInt(1)))
+	assert.Equal(t, ErrUnblindFailed, err)
+
+	// Not enough randomness
+	_, err = k.NewBlindSignerSession(bytes.NewReader(nil))
+	assert.Error(t, err)
+	_, err = NewBlinder(pk, session.Commitment(), msg, bytes.NewReader(make([]byte, 64)))
+	assert.Error(t, err)
+	// Invalid commitment
+	_, err = NewBlinder(pk, NewPoint(), msg, nil)
+	assert.ErrorIs(t, err, ErrIdentity)
+}
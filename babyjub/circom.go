@@ -0,0 +1,235 @@
+package babyjub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// The types in this file marshal to JSON using the input signal names of the
+// circomlib EdDSA verifier templates (EdDSAPoseidonVerifier and
+// EdDSAMiMCVerifier), with all the values encoded as decimal strings, as
+// expected by circom witness calculators and snarkjs.
+
+// parseDecField parses a decimal string into a *big.Int, checking that it is
+// a valid element of the finite field.
+func parseDecField(name, s string) (*big.Int, error) {
+	v, err := utils.NewIntFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if !utils.CheckBigIntInField(v) {
+		return nil, fmt.Errorf("invalid %s: value not inside Finite Field", name)
+	}
+	return v, nil
+}
+
+type signatureJSON struct {
+	R8x string `json:"R8x"`
+	R8y string `json:"R8y"`
+	S   string `json:"S"`
+}
+
+func (s *signatureJSON) signature() (*Signature, error) {
+	var sig Signature
+	var err error
+	sig.R8 = NewPoint()
+	if sig.R8.X, err = parseDecField("R8x", s.R8x); err != nil {
+		return nil, err
+	}
+	if sig.R8.Y, err = parseDecField("R8y", s.R8y); err != nil {
+		return nil, err
+	}
+	if !sig.R8.InCurve() {
+		return nil, fmt.Errorf("invalid signature: R8: %w", ErrNotOnCurve)
+	}
+	if sig.S, err = parseDecField("S", s.S); err != nil {
+		return nil, err
+	}
+	if sig.S.Cmp(SubOrder) >= 0 {
+		return nil, errors.New("invalid signature: S >= SubOrder")
+	}
+	return &sig, nil
+}
+
+// CircomSignature is a Signature that marshals to JSON using the circom
+// EdDSA verifier input names: {"R8x": ..., "R8y": ..., "S": ...}.  The
+// regular Signature keeps its default JSON encoding.
+type CircomSignature Signature
+
+// Signature returns the Signature corresponding to a CircomSignature.
+func (s *CircomSignature) Signature() *Signature {
+	return (*Signature)(s)
+}
+
+// MarshalJSON implements the json marshaler for the CircomSignature.
+func (s CircomSignature) MarshalJSON() ([]byte, error) {
+	if s.R8 == nil || s.S == nil {
+		return nil, errors.New("invalid signature: nil R8 or S")
+	}
+	return json.Marshal(signatureJSON{
+		R8x: s.R8.X.String(),
+		R8y: s.R8.Y.String(),
+		S:   s.S.String(),
+	})
+}
+
+// UnmarshalJSON implements the json unmarshaler for the CircomSignature.
+// Returns error if R8 is not in the curve or S is not lower than SubOrder.
+func (s *CircomSignature) UnmarshalJSON(b []byte) error {
+	var sj signatureJSON
+	if err := json.Unmarshal(b, &sj); err != nil {
+		return err
+	}
+	sig, err := sj.signature()
+	if err != nil {
+		return err
+	}
+	*s = CircomSignature(*sig)
+	return nil
+}
+
+// CircomPublicKey is a PublicKey that marshals to JSON using the circom EdDSA
+// verifier input names: {"Ax": ..., "Ay": ...}.  The regular PublicKey
+// marshals to its compressed hex representation.
+type CircomPublicKey PublicKey
+
+type circomPublicKeyJSON struct {
+	Ax string `json:"Ax"`
+	Ay string `json:"Ay"`
+}
+
+func (pkj *circomPublicKeyJSON) publicKey() (*PublicKey, error) {
+	var p Point
+	var err error
+	if p.X, err = parseDecField("Ax", pkj.Ax); err != nil {
+		return nil, err
+	}
+	if p.Y, err = parseDecField("Ay", pkj.Ay); err != nil {
+		return nil, err
+	}
+	pk := (*PublicKey)(&p)
+	if err := pk.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return pk, nil
+}
+
+// PublicKey returns the PublicKey corresponding to a CircomPublicKey.
+func (pk *CircomPublicKey) PublicKey() *PublicKey {
+	return (*PublicKey)(pk)
+}
+
+// MarshalJSON implements the json marshaler for the CircomPublicKey.
+func (pk CircomPublicKey) MarshalJSON() ([]byte, error) {
+	if pk.X == nil || pk.Y == nil {
+		return nil, errors.New("invalid public key: nil X or Y")
+	}
+	return json.Marshal(circomPublicKeyJSON{
+		Ax: pk.X.String(),
+		Ay: pk.Y.String(),
+	})
+}
+
+// UnmarshalJSON implements the json unmarshaler for the CircomPublicKey.
+// Returns error if the point is not in the subgroup or is the identity.
+func (pk *CircomPublicKey) UnmarshalJSON(b []byte) error {
+	var pkj circomPublicKeyJSON
+	if err := json.Unmarshal(b, &pkj); err != nil {
+		return err
+	}
+	p, err := pkj.publicKey()
+	if err != nil {
+		return err
+	}
+	*pk = CircomPublicKey(*p)
+	return nil
+}
+
+// EdDSAVerifierInputs holds the complete set of inputs of the circom
+// EdDSAPoseidonVerifier (or EdDSAMiMCVerifier) template for a public key,
+// message and signature triple.
+type EdDSAVerifierInputs struct {
+	Enabled bool
+	PK      *PublicKey
+	Msg     *big.Int
+	Sig     *Signature
+}
+
+type edDSAVerifierInputsJSON struct {
+	Enabled string `json:"enabled"`
+	circomPublicKeyJSON
+	signatureJSON
+	M string `json:"M"`
+}
+
+// NewEdDSAVerifierInputs returns the enabled verifier inputs for the public
+// key pk, the message msg and its signature sig.
+func NewEdDSAVerifierInputs(pk *PublicKey, msg *big.Int, sig *Signature) *EdDSAVerifierInputs {
+	return &EdDSAVerifierInputs{Enabled: true, PK: pk, Msg: msg, Sig: sig}
+}
+
+// MarshalJSON implements the json marshaler for the EdDSAVerifierInputs. The
+// output has the form
+// {"enabled": "1", "Ax": ..., "Ay": ..., "R8x": ..., "R8y": ..., "S": ..., "M": ...}
+// and can be used directly as the input of the circuit.
+func (in EdDSAVerifierInputs) MarshalJSON() ([]byte, error) {
+	switch {
+	case in.PK == nil || in.PK.X == nil || in.PK.Y == nil:
+		return nil, errors.New("invalid verifier inputs: nil public key")
+	case in.Sig == nil || in.Sig.R8 == nil || in.Sig.S == nil:
+		return nil, errors.New("invalid verifier inputs: nil signature")
+	case in.Msg == nil:
+		return nil, errors.New("invalid verifier inputs: nil message")
+	}
+	enabled := "0"
+	if in.Enabled {
+		enabled = "1"
+	}
+	return json.Marshal(edDSAVerifierInputsJSON{
+		Enabled: enabled,
+		circomPublicKeyJSON: circomPublicKeyJSON{
+			Ax: in.PK.X.String(),
+			Ay: in.PK.Y.String(),
+		},
+		signatureJSON: signatureJSON{
+			R8x: in.Sig.R8.X.String(),
+			R8y: in.Sig.R8.Y.String(),
+			S:   in.Sig.S.String(),
+		},
+		M: in.Msg.String(),
+	})
+}
+
+// UnmarshalJSON implements the json unmarshaler for the EdDSAVerifierInputs.
+// A missing "enabled" field is interpreted as enabled.
+func (in *EdDSAVerifierInputs) UnmarshalJSON(b []byte) error {
+	var inj edDSAVerifierInputsJSON
+	if err := json.Unmarshal(b, &inj); err != nil {
+		return err
+	}
+	var res EdDSAVerifierInputs
+	switch inj.Enabled {
+	case "", "1":
+		res.Enabled = true
+	case "0":
+		res.Enabled = false
+	default:
+		return fmt.Errorf("invalid enabled: %q", inj.Enabled)
+	}
+	var err error
+	if res.PK, err = inj.circomPublicKeyJSON.publicKey(); err != nil {
+		return err
+	}
+	if res.Sig, err = inj.signatureJSON.signature(); err != nil {
+		return err
+	}
+	if res.Msg, err = parseDecField("M", inj.M); err != nil {
+		return err
+	}
+	*in = res
+	return nil
+}
@@ -0,0 +1,128 @@
+package babyjub
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircomJSON(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	msgBuf, err := hex.DecodeString("00010203040506070809")
+	require.NoError(t, err)
+	msg := utils.SetBigIntFromLEBytes(new(big.Int), msgBuf)
+	pk := k.Public()
+	sig, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+
+	sigJSON, err := json.Marshal((*CircomSignature)(sig))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"R8x": "11384336176656855268977457483345535180380036354188103142384839473266348197733",
+		"R8y": "15383486972088797283337779941324724402501462225528836549661220478783371668959",
+		"S": "1672775540645840396591609181675628451599263765380031905495115170613215233181"
+	}`, string(sigJSON))
+	var sig2 CircomSignature
+	require.NoError(t, json.Unmarshal(sigJSON, &sig2))
+	assert.Equal(t, sig, sig2.Signature())
+
+	// The regular Signature keeps its default JSON encoding
+	sigJSON, err = json.Marshal(sig)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"R8": {
+			"X": 11384336176656855268977457483345535180380036354188103142384839473266348197733,
+			"Y": 15383486972088797283337779941324724402501462225528836549661220478783371668959
+		},
+		"S": 1672775540645840396591609181675628451599263765380031905495115170613215233181
+	}`, string(sigJSON))
+	var sig3 Signature
+	require.NoError(t, json.Unmarshal(sigJSON, &sig3))
+	assert.Equal(t, sig, &sig3)
+
+	pkJSON, err := json.Marshal((*CircomPublicKey)(pk))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"Ax": "13277427435165878497778222415993513565335242147425444199013288855685581939618",
+		"Ay": "13622229784656158136036771217484571176836296686641868549125388198837476602820"
+	}`, string(pkJSON))
+	var pk2 CircomPublicKey
+	require.NoError(t, json.Unmarshal(pkJSON, &pk2))
+	assert.Equal(t, pk, pk2.PublicKey())
+
+	inputs := NewEdDSAVerifierInputs(pk, msg, sig)
+	inputsJSON, err := json.Marshal(inputs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"enabled": "1",
+		"Ax": "13277427435165878497778222415993513565335242147425444199013288855685581939618",
+		"Ay": "13622229784656158136036771217484571176836296686641868549125388198837476602820",
+		"R8x": "11384336176656855268977457483345535180380036354188103142384839473266348197733",
+		"R8y": "15383486972088797283337779941324724402501462225528836549661220478783371668959",
+		"S": "1672775540645840396591609181675628451599263765380031905495115170613215233181",
+		"M": "42649378395939397566720"
+	}`, string(inputsJSON))
+	var inputs2 EdDSAVerifierInputs
+	require.NoError(t, json.Unmarshal(inputsJSON, &inputs2))
+	assert.Equal(t, inputs, &inputs2)
+	require.NoError(t, inputs2.PK.VerifyPoseidon(inputs2.Msg, inputs2.Sig))
+}
+
+func TestCircomJSONInvalid(t *testing.T) {
+	var sig CircomSignature
+	// R8 not in the curve
+	err := json.Unmarshal([]byte(`{"R8x": "1", "R8y": "2", "S": "3"}`), &sig)
+	assert.Error(t, err)
+	// Not a decimal number
+	err = json.Unmarshal([]byte(`{"R8x": "0x1", "R8y": "1", "S": "3"}`), &sig)
+	assert.Error(t, err)
+	// S >= SubOrder
+	err = json.Unmarshal([]byte(`{"R8x": "0", "R8y": "1", "S": "`+
+		SubOrder.String()+`"}`), &sig)
+	assert.Error(t, err)
+
+	var pk CircomPublicKey
+	err = json.Unmarshal([]byte(`{"Ax": "1", "Ay": "1"}`), &pk)
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+	err = json.Unmarshal([]byte(`{"Ax": "-1", "Ay": "1"}`), &pk)
+	assert.Error(t, err)
+
+	// The identity is not a valid public key
+	err = json.Unmarshal([]byte(`{"Ax": "0", "Ay": "1"}`), &pk)
+	assert.ErrorIs(t, err, ErrIdentity)
+
+	var inputs EdDSAVerifierInputs
+	b8 := `"Ax": "` + B8.X.String() + `", "Ay": "` + B8.Y.String() + `"`
+	err = json.Unmarshal([]byte(`{"enabled": "2", `+b8+`, `+
+		`"R8x": "0", "R8y": "1", "S": "0", "M": "0"}`), &inputs)
+	assert.Error(t, err)
+	err = json.Unmarshal([]byte(`{"enabled": "0", `+b8+`, `+
+		`"R8x": "0", "R8y": "1", "S": "0", "M": "0"}`), &inputs)
+	require.NoError(t, err)
+	assert.False(t, inputs.Enabled)
+
+	// Missing values are an error, not a panic
+	_, err = json.Marshal(EdDSAVerifierInputs{})
+	assert.Error(t, err)
+	_, err = json.Marshal(EdDSAVerifierInputs{PK: inputs.PK, Sig: &Signature{}})
+	assert.Error(t, err)
+	_, err = json.Marshal(EdDSAVerifierInputs{PK: inputs.PK, Sig: inputs.Sig})
+	assert.Error(t, err)
+	_, err = json.Marshal(CircomSignature{})
+	assert.Error(t, err)
+	_, err = json.Marshal(CircomPublicKey{})
+	assert.Error(t, err)
+	_, err = json.Marshal(CircomPublicKey{X: B8.X})
+	assert.Error(t, err)
+	_, err = json.Marshal(EdDSAVerifierInputs{PK: &PublicKey{}, Msg: big.NewInt(0),
+		Sig: inputs.Sig})
+	assert.Error(t, err)
+}
@@ -8,8 +8,10 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
 	"github.com/iden3/go-iden3-crypto/v2/mimc7"
 	"github.com/iden3/go-iden3-crypto/v2/poseidon"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
@@ -92,6 +94,19 @@ func (s *PrivKeyScalar) BigInt() *big.Int {
 	return (*big.Int)(s)
 }
 
+// NewPrivKeyScalarFromElement creates a new PrivKeyScalar from a scalar
+// modulo SubOrder.
+func NewPrivKeyScalarFromElement(e *scalar.Element) *PrivKeyScalar {
+	return NewPrivKeyScalar(e.BigInt())
+}
+
+// Element returns the PrivKeyScalar reduced modulo SubOrder.  Since B8
+// generates a subgroup of order SubOrder, the reduced scalar has the same
+// public key.
+func (s *PrivKeyScalar) Element() *scalar.Element {
+	return scalar.NewElement().SetBigInt(s.BigInt())
+}
+
 // PublicKey represents an EdDSA public key, which is a curve point.
 type PublicKey Point
 
@@ -121,6 +136,13 @@ func (pk *PublicKey) UnmarshalText(h []byte) error {
 	return nil
 }
 
+// Validate checks that the PublicKey is a point in the subgroup of the babyjub
+// curve other than the identity.  Errors wrap ErrNonCanonical, ErrNotOnCurve,
+// ErrNotInSubgroup or ErrIdentity.
+func (pk *PublicKey) Validate() error {
+	return pk.Point().checkSubGroup()
+}
+
 // Point returns the Point corresponding to a PublicKey.
 func (pk *PublicKey) Point() *Point {
 	return (*Point)(pk)
@@ -148,9 +170,21 @@ func (pk *PublicKey) Compress() PublicKeyComp {
 	return PublicKeyComp((*Point)(pk).Compress())
 }
 
-// Decompress returns the PublicKey for the given PublicKeyComp
+// BatchCompressPublicKeys returns the PublicKeyComp of each of the public keys
+// pks.
+func BatchCompressPublicKeys(pks []*PublicKey) []PublicKeyComp {
+	res := make([]PublicKeyComp, len(pks))
+	for i := range pks {
+		res[i] = pks[i].Compress()
+	}
+	return res
+}
+
+// Decompress returns the PublicKey for the given PublicKeyComp.  Returns
+// error if the compressed point is not canonical, is not in the subgroup or
+// is the identity.
 func (pkComp *PublicKeyComp) Decompress() (*PublicKey, error) {
-	point, err := NewPoint().Decompress(*pkComp)
+	point, err := NewPoint().DecompressChecked(*pkComp)
 	if err != nil {
 		return nil, err
 	}
@@ -249,48 +283,103 @@ func (s Signature) Value() (driver.Value, error) {
 	return comp[:], nil
 }
 
-// SignMimc7 signs a message encoded as a big.Int in Zq using blake-512 hash
-// for buffer hashing and mimc7 for big.Int hashing.
-func (k *PrivateKey) SignMimc7(msg *big.Int) (*Signature, error) {
+// nonce computes the EdDSA nonce r for the message msg.  When rnd is nil the
+// nonce is deterministic, r = H(H_{32..63}(k), msg).  Otherwise 32 bytes are
+// read from rnd and mixed into the hash, r = H(H_{32..63}(k), Z, msg), so that
+// the nonce stays secret even if the randomness source is broken, and differs
+// between signatures of the same message.  The optional aux bytes are hashed
+// before msg, and are used to bind the nonce to additional protocol data.
+func (k *PrivateKey) nonce(msg *big.Int, rnd io.Reader, aux []byte) (*scalar.Element, error) {
 	h1 := Blake512(k[:])
 	msgBuf := utils.BigIntLEBytes(msg)
 	msgBuf32 := [32]byte{}
 	copy(msgBuf32[:], msgBuf[:])
-	rBuf := Blake512(append(h1[32:], msgBuf32[:]...))
-	r := utils.SetBigIntFromLEBytes(new(big.Int), rBuf) // r = H(H_{32..63}(k), msg)
-	r.Mod(r, SubOrder)
-	R8 := NewPoint().Mul(r, B8) // R8 = r * 8 * B
+	rInput := append([]byte{}, h1[32:]...)
+	if rnd != nil {
+		z := [32]byte{}
+		if _, err := io.ReadFull(rnd, z[:]); err != nil {
+			return nil, err
+		}
+		rInput = append(rInput, z[:]...)
+	}
+	rInput = append(rInput, aux...)
+	rBuf := Blake512(append(rInput, msgBuf32[:]...))
+	return scalar.NewElement().SetBytesLEWide(rBuf), nil
+}
+
+// signResponse computes the EdDSA response S = r + hm * 8 * s modulo
+// SubOrder, where r is the nonce, hm the challenge and s the private scalar.
+func signResponse(r *scalar.Element, hm *big.Int, s *PrivKeyScalar) *big.Int {
+	S := scalar.NewElement().SetBigInt(hm)
+	S.Mul(S, s.Element())
+	S.Double(S).Double(S).Double(S)
+	return S.Add(S, r).BigInt()
+}
+
+// SignMimc7 signs a message encoded as a big.Int in Zq using blake-512 hash
+// for buffer hashing and mimc7 for big.Int hashing.
+func (k *PrivateKey) SignMimc7(msg *big.Int) (*Signature, error) {
+	return k.signMimc7(msg, nil)
+}
+
+// SignMimc7Hedged signs a message like SignMimc7, but mixes 32 bytes of fresh
+// randomness read from rnd into the nonce derivation.  If rnd is nil,
+// crypto/rand.Reader is used.  The signature verifies with VerifyMimc7.
+func (k *PrivateKey) SignMimc7Hedged(msg *big.Int, rnd io.Reader) (*Signature, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	return k.signMimc7(msg, rnd)
+}
+
+func (k *PrivateKey) signMimc7(msg *big.Int, rnd io.Reader) (*Signature, error) {
+	r, err := k.nonce(msg, rnd, nil)
+	if err != nil {
+		return nil, err
+	}
+	R8 := NewPoint().MulScalar(r, B8) // R8 = r * 8 * B
 	A := k.Public().Point()
 	hmInput := []*big.Int{R8.X, R8.Y, A.X, A.Y, msg}
 	hm, err := mimc7.Hash(hmInput, nil) // hm = H1(8*R.x, 8*R.y, A.x, A.y, msg)
 	if err != nil {
 		return nil, err
 	}
-	S := new(big.Int).Lsh(k.Scalar().BigInt(), 3)
-	S = S.Mul(hm, S)
-	S.Add(r, S)
-	S.Mod(S, SubOrder) // S = r + hm * 8 * s
+	S := signResponse(r, hm, k.Scalar()) // S = r + hm * 8 * s
 
 	return &Signature{R8: R8, S: S}, nil
 }
 
+// verifyEquationFunc checks the EdDSA verification equation
+// S * B8 = R8 + 8 * hm * A for a given public key A.
+type verifyEquationFunc func(R8 *Point, S, hm *big.Int) bool
+
+// verifyEquation returns true when S * B8 = R8 + 8 * hm * A, where A is the
+// public key pk.  The equation is evaluated as S * B8 - 8 * hm * A = R8, with
+// a single double-scalar multiplication, and the result is compared with R8
+// in projective coordinates.
+func (pk *PublicKey) verifyEquation(R8 *Point, S, hm *big.Int) bool {
+	if S.Sign() < 0 {
+		return false
+	}
+	hm8 := new(big.Int).Lsh(hm, 3)
+	res := doubleMul(S, b8Table, hm8, newNegPointTable(pk.Point()))
+	return res.EqualAffine(R8)
+}
+
 // VerifyMimc7 verifies the signature of a message encoded as a big.Int in Zq
 // using blake-512 hash for buffer hashing and mimc7 for big.Int hashing.
 func (pk *PublicKey) VerifyMimc7(msg *big.Int, sig *Signature) error {
+	return verifyMimc7(pk, pk.verifyEquation, msg, sig)
+}
+
+func verifyMimc7(pk *PublicKey, eq verifyEquationFunc, msg *big.Int, sig *Signature) error {
 	hmInput := []*big.Int{sig.R8.X, sig.R8.Y, pk.X, pk.Y, msg}
 	hm, err := mimc7.Hash(hmInput, nil) // hm = H1(8*R.x, 8*R.y, A.x, A.y, msg)
 	if err != nil {
 		return err
 	}
 
-	left := NewPoint().Mul(sig.S, B8) // left = s * 8 * B
-	r1 := big.NewInt(8)
-	r1.Mul(r1, hm)
-	right := NewPoint().Mul(r1, pk.Point())
-	rightProj := right.Projective()
-	rightProj.Add(sig.R8.Projective(), rightProj) // right = 8 * R + 8 * hm * A
-	right = rightProj.Affine()
-	if (left.X.Cmp(right.X) == 0) && (left.Y.Cmp(right.Y) == 0) {
+	if eq(sig.R8, sig.S, hm) {
 		return nil
 	}
 	return ErrVerifyMimc7Failed
@@ -299,14 +388,25 @@ func (pk *PublicKey) VerifyMimc7(msg *big.Int, sig *Signature) error {
 // SignPoseidon signs a message encoded as a big.Int in Zq using blake-512 hash
 // for buffer hashing and Poseidon for big.Int hashing.
 func (k *PrivateKey) SignPoseidon(msg *big.Int) (*Signature, error) {
-	h1 := Blake512(k[:])
-	msgBuf := utils.BigIntLEBytes(msg)
-	msgBuf32 := [32]byte{}
-	copy(msgBuf32[:], msgBuf[:])
-	rBuf := Blake512(append(h1[32:], msgBuf32[:]...))
-	r := utils.SetBigIntFromLEBytes(new(big.Int), rBuf) // r = H(H_{32..63}(k), msg)
-	r.Mod(r, SubOrder)
-	R8 := NewPoint().Mul(r, B8) // R8 = r * 8 * B
+	return k.signPoseidon(msg, nil)
+}
+
+// SignPoseidonHedged signs a message like SignPoseidon, but mixes 32 bytes of
+// fresh randomness read from rnd into the nonce derivation.  If rnd is nil,
+// crypto/rand.Reader is used.  The signature verifies with VerifyPoseidon.
+func (k *PrivateKey) SignPoseidonHedged(msg *big.Int, rnd io.Reader) (*Signature, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	return k.signPoseidon(msg, rnd)
+}
+
+func (k *PrivateKey) signPoseidon(msg *big.Int, rnd io.Reader) (*Signature, error) {
+	r, err := k.nonce(msg, rnd, nil)
+	if err != nil {
+		return nil, err
+	}
+	R8 := NewPoint().MulScalar(r, B8) // R8 = r * 8 * B
 	A := k.Public().Point()
 
 	hmInput := []*big.Int{R8.X, R8.Y, A.X, A.Y, msg}
@@ -315,36 +415,108 @@ func (k *PrivateKey) SignPoseidon(msg *big.Int) (*Signature, error) {
 		return nil, err
 	}
 
-	S := new(big.Int).Lsh(k.Scalar().BigInt(), 3)
-	S = S.Mul(hm, S)
-	S.Add(r, S)
-	S.Mod(S, SubOrder) // S = r + hm * 8 * s
+	S := signResponse(r, hm, k.Scalar()) // S = r + hm * 8 * s
 
 	return &Signature{R8: R8, S: S}, nil
 }
 
+// challengePoseidon computes the EdDSA-Poseidon challenge
+// hm = H1(8*R.x, 8*R.y, A.x, A.y, msg) used by SignPoseidon and VerifyPoseidon.
+func challengePoseidon(R8, A *Point, msg *big.Int) (*big.Int, error) {
+	return poseidon.Hash([]*big.Int{R8.X, R8.Y, A.X, A.Y, msg})
+}
+
 // VerifyPoseidon verifies the signature of a message encoded as a big.Int in Zq
 // using blake-512 hash for buffer hashing and Poseidon for big.Int hashing.
 func (pk *PublicKey) VerifyPoseidon(msg *big.Int, sig *Signature) error {
-	hmInput := []*big.Int{sig.R8.X, sig.R8.Y, pk.X, pk.Y, msg}
-	hm, err := poseidon.Hash(hmInput) // hm = H1(8*R.x, 8*R.y, A.x, A.y, msg)
+	return verifyPoseidon(pk, pk.verifyEquation, msg, sig)
+}
+
+func verifyPoseidon(pk *PublicKey, eq verifyEquationFunc, msg *big.Int, sig *Signature) error {
+	hm, err := challengePoseidon(sig.R8, pk.Point(), msg) // hm = H1(8*R.x, 8*R.y, A.x, A.y, msg)
 	if err != nil {
 		return err
 	}
 
-	left := NewPoint().Mul(sig.S, B8) // left = s * 8 * B
-	r1 := big.NewInt(8)
-	r1.Mul(r1, hm)
-	right := NewPoint().Mul(r1, pk.Point())
-	rightProj := right.Projective()
-	rightProj.Add(sig.R8.Projective(), rightProj) // right = 8 * R + 8 * hm * A
-	right = rightProj.Affine()
-	if (left.X.Cmp(right.X) == 0) && (left.Y.Cmp(right.Y) == 0) {
+	if eq(sig.R8, sig.S, hm) {
 		return nil
 	}
 	return ErrVerifyPoseidonFailed
 }
 
+// HashPoseidonMsgElems hashes a message made of many field elements into a
+// single field element that can be signed with SignPoseidon, using
+// poseidon.SpongeHash: the elements are absorbed in frames of 16 inputs;
+// after the first frame, the first input of every frame is the hash of the
+// previous one, and the last frame is padded with zeros.  The construction
+// only uses the regular Poseidon hash, so it can be reproduced inside a
+// circuit, and matches the JS implementations.  The digest does not commit
+// to the message length: [m] and [m, 0] have the same digest.  Messages that
+// need it can be hashed with poseidon.SpongeHashPadded and signed with
+// SignPoseidon.
+func HashPoseidonMsgElems(msg []*big.Int) (*big.Int, error) {
+	if len(msg) == 0 {
+		return nil, errors.New("empty message")
+	}
+	return poseidon.SpongeHash(msg)
+}
+
+// HashPoseidonMsgBytes hashes a byte message into a single field element
+// that can be signed with SignPoseidon, using poseidon.HashBytes: the
+// message is split into chunks of 31 bytes, each one read as a big-endian
+// integer with the last chunk padded with zeros on the right, and the
+// resulting elements are hashed with HashPoseidonMsgElems.  Messages that
+// differ in trailing zero bytes can have the same digest; those that need to
+// be told apart can be hashed with poseidon.HashBytesPadded and signed with
+// SignPoseidon.
+func HashPoseidonMsgBytes(msg []byte) (*big.Int, error) {
+	if len(msg) == 0 {
+		return nil, errors.New("empty message")
+	}
+	return poseidon.HashBytes(msg)
+}
+
+// SignPoseidonElems signs a message made of many field elements.  The
+// message is hashed with HashPoseidonMsgElems and the digest is signed with
+// SignPoseidon.
+func (k *PrivateKey) SignPoseidonElems(msg []*big.Int) (*Signature, error) {
+	hm, err := HashPoseidonMsgElems(msg)
+	if err != nil {
+		return nil, err
+	}
+	return k.SignPoseidon(hm)
+}
+
+// VerifyPoseidonElems verifies the signature of a message made of many field
+// elements, created with SignPoseidonElems.
+func (pk *PublicKey) VerifyPoseidonElems(msg []*big.Int, sig *Signature) error {
+	hm, err := HashPoseidonMsgElems(msg)
+	if err != nil {
+		return err
+	}
+	return pk.VerifyPoseidon(hm, sig)
+}
+
+// SignPoseidonBytes signs a byte message.  The message is hashed with
+// HashPoseidonMsgBytes and the digest is signed with SignPoseidon.
+func (k *PrivateKey) SignPoseidonBytes(msg []byte) (*Signature, error) {
+	hm, err := HashPoseidonMsgBytes(msg)
+	if err != nil {
+		return nil, err
+	}
+	return k.SignPoseidon(hm)
+}
+
+// VerifyPoseidonBytes verifies the signature of a byte message, created with
+// SignPoseidonBytes.
+func (pk *PublicKey) VerifyPoseidonBytes(msg []byte, sig *Signature) error {
+	hm, err := HashPoseidonMsgBytes(msg)
+	if err != nil {
+		return err
+	}
+	return pk.VerifyPoseidon(hm, sig)
+}
+
 // Scan implements Scanner for database/sql.
 func (pk *PublicKey) Scan(src interface{}) error {
 	srcB, ok := src.([]byte)
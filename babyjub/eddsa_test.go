@@ -1,6 +1,7 @@
 package babyjub
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/hex"
@@ -8,7 +9,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
 	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/iden3/go-iden3-crypto/v2/poseidon"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +27,27 @@ func TestPublicKey(t *testing.T) {
 	assert.True(t, pk.Y.Cmp(constants.Q) == -1)
 }
 
+func TestPrivKeyScalarElement(t *testing.T) {
+	var k PrivateKey
+	for i := 0; i < 32; i++ {
+		k[i] = byte(i)
+	}
+	s := k.Scalar()
+	e := s.Element()
+	assert.Equal(t, new(big.Int).Mod(s.BigInt(), SubOrder), e.BigInt())
+	// the reduced scalar has the same public key
+	assert.Equal(t, k.Public(), NewPrivKeyScalarFromElement(e).Public())
+
+	// signResponse matches S = r + hm * 8 * s mod SubOrder
+	r := scalar.NewElementFromUint64(42)
+	hm := new(big.Int).Sub(constants.Q, big.NewInt(1))
+	expected := new(big.Int).Lsh(s.BigInt(), 3)
+	expected.Mul(expected, hm)
+	expected.Add(expected, big.NewInt(42))
+	expected.Mod(expected, SubOrder)
+	assert.Equal(t, expected, signResponse(&r, hm, s))
+}
+
 func TestSignVerifyMimc7(t *testing.T) {
 	var k PrivateKey
 	_, err := hex.Decode(k[:],
@@ -232,6 +256,16 @@ func BenchmarkBabyjubEddsa(b *testing.B) {
 		}
 	})
 
+	ppk, err := pk.Prepare()
+	require.NoError(b, err)
+
+	b.Run("VerifyMimc7Prepared", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err = ppk.VerifyMimc7(msgs[i%n], sigs[i%n])
+			require.NoError(b, err)
+		}
+	})
+
 	b.Run("SignPoseidon", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
 			_, err = k.SignPoseidon(msgs[i%n])
@@ -250,4 +284,224 @@ func BenchmarkBabyjubEddsa(b *testing.B) {
 			require.NoError(b, err)
 		}
 	})
+
+	b.Run("VerifyPoseidonPrepared", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			err = ppk.VerifyPoseidon(msgs[i%n], sigs[i%n])
+			require.NoError(b, err)
+		}
+	})
+}
+
+func TestSignVerifyPoseidonElems(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+
+	// A message that fits in a single frame is hashed with a single Poseidon
+	// call over the zero-padded frame.
+	msg := make([]*big.Int, 8)
+	for i := range msg {
+		msg[i] = big.NewInt(int64(i + 1))
+	}
+	hm, err := HashPoseidonMsgElems(msg)
+	require.NoError(t, err)
+	frame := make([]*big.Int, 16)
+	for i := range frame {
+		frame[i] = big.NewInt(0)
+	}
+	copy(frame, msg)
+	expected, err := poseidon.Hash(frame)
+	require.NoError(t, err)
+	assert.Equal(t, expected, hm)
+
+	sig, err := k.SignPoseidonElems(msg)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyPoseidonElems(msg, sig))
+	require.NoError(t, pk.VerifyPoseidon(hm, sig))
+
+	// A longer message chains the hash of each frame into the next one.
+	msg = make([]*big.Int, 20)
+	for i := range msg {
+		msg[i] = big.NewInt(int64(i + 1))
+	}
+	hm, err = HashPoseidonMsgElems(msg)
+	require.NoError(t, err)
+	h0, err := poseidon.Hash(msg[:16])
+	require.NoError(t, err)
+	frame = make([]*big.Int, 16)
+	for i := range frame {
+		frame[i] = big.NewInt(0)
+	}
+	frame[0] = h0
+	copy(frame[1:], msg[16:])
+	expected, err = poseidon.Hash(frame)
+	require.NoError(t, err)
+	assert.Equal(t, expected, hm)
+
+	sig, err = k.SignPoseidonElems(msg)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyPoseidonElems(msg, sig))
+	require.NoError(t, pk.VerifyPoseidon(hm, sig))
+
+	msg[3] = big.NewInt(0)
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidonElems(msg, sig))
+
+	_, err = k.SignPoseidonElems(nil)
+	assert.Error(t, err)
+	_, err = k.SignPoseidonElems([]*big.Int{constants.Q})
+	assert.Error(t, err)
+}
+
+func TestSignVerifyPoseidonBytes(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+
+	msg := []byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do " +
+		"eiusmod tempor incididunt ut labore et dolore magna aliqua.")
+	hm, err := HashPoseidonMsgBytes(msg)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"21494492944852560097804134222456343209501827109244058509061799844269207024120",
+		hm.String())
+	// 31 byte big-endian chunks, the last one padded with zeros on the right
+	var last [31]byte
+	copy(last[:], msg[93:])
+	elems := []*big.Int{
+		new(big.Int).SetBytes(msg[:31]),
+		new(big.Int).SetBytes(msg[31:62]),
+		new(big.Int).SetBytes(msg[62:93]),
+		new(big.Int).SetBytes(last[:]),
+	}
+	expected, err := HashPoseidonMsgElems(elems)
+	require.NoError(t, err)
+	assert.Equal(t, expected, hm)
+
+	sig, err := k.SignPoseidonBytes(msg)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyPoseidonBytes(msg, sig))
+	require.NoError(t, pk.VerifyPoseidon(hm, sig))
+
+	msg[0] = 'l'
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidonBytes(msg, sig))
+
+	_, err = k.SignPoseidonBytes([]byte{})
+	assert.Error(t, err)
+}
+
+func TestHashPoseidonMsgLength(t *testing.T) {
+	// The zero padding of the sponge does not commit to the message length,
+	// so messages that differ in trailing zeros have the same digest.  The
+	// padded sponge tells them apart.
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+
+	m := big.NewInt(42)
+	h1, err := HashPoseidonMsgElems([]*big.Int{m})
+	require.NoError(t, err)
+	h2, err := HashPoseidonMsgElems([]*big.Int{m, big.NewInt(0)})
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+
+	h1, err = poseidon.SpongeHashPadded([]*big.Int{m})
+	require.NoError(t, err)
+	h2, err = poseidon.SpongeHashPadded([]*big.Int{m, big.NewInt(0)})
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h2)
+	sig, err := k.SignPoseidon(h1)
+	require.NoError(t, err)
+	assert.NoError(t, pk.VerifyPoseidon(h1, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(h2, sig))
+
+	h1, err = HashPoseidonMsgBytes([]byte("a"))
+	require.NoError(t, err)
+	h2, err = HashPoseidonMsgBytes([]byte("a\x00"))
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+
+	h1, err = poseidon.HashBytesPadded([]byte("a"))
+	require.NoError(t, err)
+	h2, err = poseidon.HashBytesPadded([]byte("a\x00"))
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, h2)
+	sig, err = k.SignPoseidon(h1)
+	require.NoError(t, err)
+	assert.NoError(t, pk.VerifyPoseidon(h1, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(h2, sig))
+}
+
+func TestSignVerifyHedged(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+	msg := big.NewInt(123456789)
+
+	sigDet, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+	sig1, err := k.SignPoseidonHedged(msg, nil)
+	require.NoError(t, err)
+	sig2, err := k.SignPoseidonHedged(msg, nil)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyPoseidon(msg, sig1))
+	require.NoError(t, pk.VerifyPoseidon(msg, sig2))
+	assert.NotEqual(t, sigDet.Compress(), sig1.Compress())
+	assert.NotEqual(t, sig1.Compress(), sig2.Compress())
+
+	// The same randomness gives the same signature.
+	z := bytes.Repeat([]byte{0x42}, 32)
+	sig1, err = k.SignPoseidonHedged(msg, bytes.NewReader(z))
+	require.NoError(t, err)
+	sig2, err = k.SignPoseidonHedged(msg, bytes.NewReader(z))
+	require.NoError(t, err)
+	assert.Equal(t, sig1.Compress(), sig2.Compress())
+
+	sigDet, err = k.SignMimc7(msg)
+	require.NoError(t, err)
+	sig1, err = k.SignMimc7Hedged(msg, nil)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyMimc7(msg, sig1))
+	assert.NotEqual(t, sigDet.Compress(), sig1.Compress())
+
+	// Not enough randomness
+	_, err = k.SignPoseidonHedged(msg, bytes.NewReader(z[:16]))
+	assert.Error(t, err)
+	_, err = k.SignMimc7Hedged(msg, bytes.NewReader(nil))
+	assert.Error(t, err)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+	msg := big.NewInt(123456789)
+
+	sig, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+	sigS := &Signature{R8: sig.R8, S: new(big.Int).Add(sig.S, big.NewInt(1))}
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(msg, sigS))
+	sigR := &Signature{R8: NewPoint().Mul(big.NewInt(2), sig.R8), S: sig.S}
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(msg, sigR))
+	sigNeg := &Signature{R8: sig.R8, S: new(big.Int).Sub(sig.S, SubOrder)}
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(msg, sigNeg))
+	// S + SubOrder is accepted, as before
+	sigL := &Signature{R8: sig.R8, S: new(big.Int).Add(sig.S, SubOrder)}
+	assert.NoError(t, pk.VerifyPoseidon(msg, sigL))
+
+	sig, err = k.SignMimc7(msg)
+	require.NoError(t, err)
+	sigS = &Signature{R8: sig.R8, S: new(big.Int).Add(sig.S, big.NewInt(1))}
+	assert.Equal(t, ErrVerifyMimc7Failed, pk.VerifyMimc7(msg, sigS))
+	assert.Equal(t, ErrVerifyMimc7Failed, pk.VerifyMimc7(big.NewInt(1), sig))
 }
@@ -0,0 +1,347 @@
+package babyjub
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// Uncompressed encodings store every coordinate (and the S value of
+// signatures) as a 32 byte integer, either big-endian, as used in EVM
+// calldata, or little-endian, as used by the compressed encodings.
+//
+// Binary encodings (encoding.BinaryMarshaler and encoding.BinaryUnmarshaler)
+// use the compressed form: 32 bytes for points and public keys, and 64 bytes
+// for signatures.
+
+// putBE writes v as a 32 byte big-endian integer into dst.
+func putBE(dst []byte, v *big.Int) {
+	v.FillBytes(dst[:32])
+}
+
+// putLE writes v as a 32 byte little-endian integer into dst.
+func putLE(dst []byte, v *big.Int) {
+	le := utils.BigIntLEBytes(v)
+	copy(dst[:32], le[:])
+}
+
+// readCoord reads a 32 byte integer from src, checking that it is inside the
+// finite field.
+func readCoord(name string, src []byte, bigEndian bool) (*big.Int, error) {
+	v := new(big.Int)
+	if bigEndian {
+		v.SetBytes(src[:32])
+	} else {
+		utils.SetBigIntFromLEBytes(v, src[:32])
+	}
+	if !utils.CheckBigIntInField(v) {
+		return nil, fmt.Errorf("%w: %s >= Q", ErrNonCanonical, name)
+	}
+	return v, nil
+}
+
+// checkLen returns an error if the length of buf is not n.
+func checkLen(typ string, buf []byte, n int) error {
+	if len(buf) != n {
+		return fmt.Errorf("can't unmarshal []byte of len %d into %s, want %d", len(buf), typ, n)
+	}
+	return nil
+}
+
+func (p *Point) uncompressed(bigEndian bool) [64]byte {
+	var buf [64]byte
+	if bigEndian {
+		putBE(buf[:32], p.X)
+		putBE(buf[32:], p.Y)
+	} else {
+		putLE(buf[:32], p.X)
+		putLE(buf[32:], p.Y)
+	}
+	return buf
+}
+
+func (p *Point) setUncompressed(buf [64]byte, bigEndian bool) (*Point, error) {
+	x, err := readCoord("x", buf[:32], bigEndian)
+	if err != nil {
+		return nil, err
+	}
+	y, err := readCoord("y", buf[32:], bigEndian)
+	if err != nil {
+		return nil, err
+	}
+	q := &Point{X: x, Y: y}
+	if !q.InCurve() {
+		return nil, ErrNotOnCurve
+	}
+	p.X, p.Y = q.X, q.Y
+	return p, nil
+}
+
+// UncompressedBE returns the 64 byte uncompressed encoding of the Point, made
+// of the big-endian encodings of X and Y.
+func (p *Point) UncompressedBE() [64]byte {
+	return p.uncompressed(true)
+}
+
+// UncompressedLE returns the 64 byte uncompressed encoding of the Point, made
+// of the little-endian encodings of X and Y.
+func (p *Point) UncompressedLE() [64]byte {
+	return p.uncompressed(false)
+}
+
+// SetUncompressedBE sets p from the encoding returned by UncompressedBE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical or ErrNotOnCurve
+// if the encoding is invalid.
+func (p *Point) SetUncompressedBE(buf [64]byte) (*Point, error) {
+	return p.setUncompressed(buf, true)
+}
+
+// SetUncompressedLE sets p from the encoding returned by UncompressedLE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical or ErrNotOnCurve
+// if the encoding is invalid.
+func (p *Point) SetUncompressedLE(buf [64]byte) (*Point, error) {
+	return p.setUncompressed(buf, false)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the Point, using the
+// 32 byte compressed encoding.
+func (p Point) MarshalBinary() ([]byte, error) {
+	buf := p.Compress()
+	return buf[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the Point.  The
+// point must be in the curve, but it may be outside of the subgroup.  The
+// encoding must be canonical: y must be inside the finite field, and the sign
+// bit must not be set when x = 0.  Errors wrap ErrNonCanonical or
+// ErrNotOnCurve.
+func (p *Point) UnmarshalBinary(b []byte) error {
+	if err := checkLen("Point", b, 32); err != nil { //nolint:gomnd
+		return err
+	}
+	var buf [32]byte
+	copy(buf[:], b)
+	sign, y := UnpackSignY(buf)
+	q, err := PointFromSignAndY(sign, y)
+	if err != nil {
+		return err
+	}
+	if sign && q.X.Sign() == 0 {
+		return fmt.Errorf("%w: sign set for x = 0", ErrNonCanonical)
+	}
+	*p = *q
+	return nil
+}
+
+// UncompressedBE returns the 64 byte uncompressed big-endian encoding of the
+// PublicKey.
+func (pk *PublicKey) UncompressedBE() [64]byte {
+	return pk.Point().UncompressedBE()
+}
+
+// UncompressedLE returns the 64 byte uncompressed little-endian encoding of
+// the PublicKey.
+func (pk *PublicKey) UncompressedLE() [64]byte {
+	return pk.Point().UncompressedLE()
+}
+
+func (pk *PublicKey) setUncompressed(buf [64]byte, bigEndian bool) (*PublicKey, error) {
+	p, err := NewPoint().setUncompressed(buf, bigEndian)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkSubGroup(); err != nil {
+		return nil, err
+	}
+	*pk = PublicKey(*p)
+	return pk, nil
+}
+
+// SetUncompressedBE sets pk from the encoding returned by UncompressedBE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical, ErrNotOnCurve,
+// ErrNotInSubgroup or ErrIdentity if the encoding is invalid.
+func (pk *PublicKey) SetUncompressedBE(buf [64]byte) (*PublicKey, error) {
+	return pk.setUncompressed(buf, true)
+}
+
+// SetUncompressedLE sets pk from the encoding returned by UncompressedLE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical, ErrNotOnCurve,
+// ErrNotInSubgroup or ErrIdentity if the encoding is invalid.
+func (pk *PublicKey) SetUncompressedLE(buf [64]byte) (*PublicKey, error) {
+	return pk.setUncompressed(buf, false)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the PublicKey, using
+// the 32 byte compressed encoding.
+func (pk PublicKey) MarshalBinary() ([]byte, error) {
+	buf := pk.Compress()
+	return buf[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the PublicKey.
+// The key must be in the subgroup and must not be the identity.
+func (pk *PublicKey) UnmarshalBinary(b []byte) error {
+	if err := checkLen("PublicKey", b, 32); err != nil { //nolint:gomnd
+		return err
+	}
+	var comp PublicKeyComp
+	copy(comp[:], b)
+	decomp, err := comp.Decompress()
+	if err != nil {
+		return err
+	}
+	*pk = *decomp
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the PublicKeyComp.
+func (pkComp PublicKeyComp) MarshalBinary() ([]byte, error) {
+	return pkComp[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the
+// PublicKeyComp.  Returns error if the data is not a valid compressed public
+// key.
+func (pkComp *PublicKeyComp) UnmarshalBinary(b []byte) error {
+	if err := checkLen("PublicKeyComp", b, 32); err != nil { //nolint:gomnd
+		return err
+	}
+	var comp PublicKeyComp
+	copy(comp[:], b)
+	if _, err := comp.Decompress(); err != nil {
+		return err
+	}
+	*pkComp = comp
+	return nil
+}
+
+func (s *Signature) uncompressed(bigEndian bool) [96]byte {
+	var buf [96]byte
+	r8 := s.R8.uncompressed(bigEndian)
+	copy(buf[:64], r8[:])
+	if bigEndian {
+		putBE(buf[64:], s.S)
+	} else {
+		putLE(buf[64:], s.S)
+	}
+	return buf
+}
+
+func (s *Signature) setUncompressed(buf [96]byte, bigEndian bool) (*Signature, error) {
+	var r8Buf [64]byte
+	copy(r8Buf[:], buf[:64])
+	R8, err := NewPoint().setUncompressed(r8Buf, bigEndian)
+	if err != nil {
+		return nil, err
+	}
+	S, err := readScalar(buf[64:], bigEndian)
+	if err != nil {
+		return nil, err
+	}
+	s.R8, s.S = R8, S
+	return s, nil
+}
+
+// readScalar reads the S value of a signature, checking that it is lower
+// than SubOrder.
+func readScalar(src []byte, bigEndian bool) (*big.Int, error) {
+	v := new(big.Int)
+	if bigEndian {
+		v.SetBytes(src[:32])
+	} else {
+		utils.SetBigIntFromLEBytes(v, src[:32])
+	}
+	if v.Cmp(SubOrder) >= 0 {
+		return nil, fmt.Errorf("%w: S >= SubOrder", ErrNonCanonical)
+	}
+	return v, nil
+}
+
+// UncompressedBE returns the 96 byte uncompressed encoding of the Signature,
+// made of the big-endian encodings of R8.X, R8.Y and S.
+func (s *Signature) UncompressedBE() [96]byte {
+	return s.uncompressed(true)
+}
+
+// UncompressedLE returns the 96 byte uncompressed encoding of the Signature,
+// made of the little-endian encodings of R8.X, R8.Y and S.
+func (s *Signature) UncompressedLE() [96]byte {
+	return s.uncompressed(false)
+}
+
+// SetUncompressedBE sets s from the encoding returned by UncompressedBE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical or ErrNotOnCurve
+// if the encoding is invalid.
+func (s *Signature) SetUncompressedBE(buf [96]byte) (*Signature, error) {
+	return s.setUncompressed(buf, true)
+}
+
+// SetUncompressedLE sets s from the encoding returned by UncompressedLE, and
+// also returns it.  Returns an error wrapping ErrNonCanonical or ErrNotOnCurve
+// if the encoding is invalid.
+func (s *Signature) SetUncompressedLE(buf [96]byte) (*Signature, error) {
+	return s.setUncompressed(buf, false)
+}
+
+// decompressChecked decompresses a compressed signature into s, also
+// checking that the encoding of R8 is canonical, as Point.UnmarshalBinary
+// does, and that S is lower than SubOrder.
+func (s *Signature) decompressChecked(buf [64]byte) (*Signature, error) {
+	var r8 Point
+	if err := r8.UnmarshalBinary(buf[:32]); err != nil {
+		return nil, err
+	}
+	S, err := readScalar(buf[32:], false)
+	if err != nil {
+		return nil, err
+	}
+	s.R8 = &r8
+	s.S = S
+	return s, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the Signature, using
+// the 64 byte compressed encoding.
+func (s Signature) MarshalBinary() ([]byte, error) {
+	buf := s.Compress()
+	return buf[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the Signature.
+// Returns error if R8 is not in the curve or its encoding is not canonical,
+// or if S is not lower than SubOrder.
+func (s *Signature) UnmarshalBinary(b []byte) error {
+	if err := checkLen("Signature", b, 64); err != nil { //nolint:gomnd
+		return err
+	}
+	var buf [64]byte
+	copy(buf[:], b)
+	var sig Signature
+	if _, err := sig.decompressChecked(buf); err != nil {
+		return err
+	}
+	*s = sig
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler for the SignatureComp.
+func (sComp SignatureComp) MarshalBinary() ([]byte, error) {
+	return sComp[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the
+// SignatureComp.  Returns error if the data is not a valid compressed
+// signature.
+func (sComp *SignatureComp) UnmarshalBinary(b []byte) error {
+	if err := checkLen("SignatureComp", b, 64); err != nil { //nolint:gomnd
+		return err
+	}
+	var buf [64]byte
+	copy(buf[:], b)
+	if _, err := new(Signature).decompressChecked(buf); err != nil {
+		return err
+	}
+	*sComp = SignatureComp(buf)
+	return nil
+}
@@ -0,0 +1,172 @@
+package babyjub
+
+import (
+	"encoding"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Point{}
+	_ encoding.BinaryUnmarshaler = &Point{}
+	_ encoding.BinaryMarshaler   = PublicKey{}
+	_ encoding.BinaryUnmarshaler = &PublicKey{}
+	_ encoding.BinaryMarshaler   = PublicKeyComp{}
+	_ encoding.BinaryUnmarshaler = &PublicKeyComp{}
+	_ encoding.BinaryMarshaler   = Signature{}
+	_ encoding.BinaryUnmarshaler = &Signature{}
+	_ encoding.BinaryMarshaler   = SignatureComp{}
+	_ encoding.BinaryUnmarshaler = &SignatureComp{}
+)
+
+func TestPointUncompressed(t *testing.T) {
+	be := B8.UncompressedBE()
+	assert.Equal(t, ""+
+		"0bb77a6ad63e739b4eacb2e09d6277c12ab8d8010534e0b62893f3f6bb957051"+
+		"25797203f7a0b24925572e1cd16bf9edfce0051fb9e133774b3c257a872d7d8b",
+		hex.EncodeToString(be[:]))
+	le := B8.UncompressedLE()
+	assert.Equal(t, ""+
+		"517095bbf6f39328b6e0340501d8b82ac177629de0b2ac4e9b733ed66a7ab70b"+
+		"8b7d2d877a253c4b7733e1b91f05e0fcedf96bd11c2e572549b2a0f703727925",
+		hex.EncodeToString(le[:]))
+
+	p, err := NewPoint().SetUncompressedBE(be)
+	require.NoError(t, err)
+	assert.Equal(t, B8, p)
+	p, err = NewPoint().SetUncompressedLE(le)
+	require.NoError(t, err)
+	assert.Equal(t, B8, p)
+
+	// Not in the curve
+	be[63]++
+	_, err = NewPoint().SetUncompressedBE(be)
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+	// x >= Q
+	q := &Point{X: new(big.Int).Add(B8.X, constants.Q), Y: B8.Y}
+	_, err = NewPoint().SetUncompressedLE(q.UncompressedLE())
+	assert.ErrorIs(t, err, ErrNonCanonical)
+
+	pk := (*PublicKey)(B8)
+	pk2, err := new(PublicKey).SetUncompressedBE(pk.UncompressedBE())
+	require.NoError(t, err)
+	assert.Equal(t, pk, pk2)
+	pk2, err = new(PublicKey).SetUncompressedLE(pk.UncompressedLE())
+	require.NoError(t, err)
+	assert.Equal(t, pk, pk2)
+
+	// Public keys must be in the subgroup
+	notSub := &PublicKey{
+		X: new(big.Int).Sub(constants.Q, B8.X),
+		Y: new(big.Int).Sub(constants.Q, B8.Y),
+	}
+	_, err = new(PublicKey).SetUncompressedBE(notSub.UncompressedBE())
+	assert.ErrorIs(t, err, ErrNotInSubgroup)
+	_, err = new(PublicKey).SetUncompressedBE((*PublicKey)(NewPoint()).UncompressedBE())
+	assert.ErrorIs(t, err, ErrIdentity)
+}
+
+func TestSignatureUncompressed(t *testing.T) {
+	k := PrivateKey{}
+	sig, err := k.SignPoseidon(big.NewInt(1234))
+	require.NoError(t, err)
+
+	sig2, err := new(Signature).SetUncompressedBE(sig.UncompressedBE())
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+	sig2, err = new(Signature).SetUncompressedLE(sig.UncompressedLE())
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+
+	be := sig.UncompressedBE()
+	copy(be[64:], SubOrder.FillBytes(make([]byte, 32)))
+	_, err = new(Signature).SetUncompressedBE(be)
+	assert.ErrorIs(t, err, ErrNonCanonical)
+}
+
+func TestBinaryMarshaler(t *testing.T) {
+	k := PrivateKey{}
+	pk := k.Public()
+	sig, err := k.SignPoseidon(big.NewInt(1234))
+	require.NoError(t, err)
+
+	b, err := pk.Point().MarshalBinary()
+	require.NoError(t, err)
+	comp := pk.Compress()
+	assert.Equal(t, comp[:], b)
+	var p Point
+	require.NoError(t, p.UnmarshalBinary(b))
+	assert.Equal(t, pk.Point(), &p)
+
+	b, err = pk.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, comp[:], b)
+	var pk2 PublicKey
+	require.NoError(t, pk2.UnmarshalBinary(b))
+	assert.Equal(t, pk, &pk2)
+
+	b, err = comp.MarshalBinary()
+	require.NoError(t, err)
+	var comp2 PublicKeyComp
+	require.NoError(t, comp2.UnmarshalBinary(b))
+	assert.Equal(t, comp, comp2)
+
+	b, err = sig.MarshalBinary()
+	require.NoError(t, err)
+	sigComp := sig.Compress()
+	assert.Equal(t, sigComp[:], b)
+	var sig2 Signature
+	require.NoError(t, sig2.UnmarshalBinary(b))
+	assert.Equal(t, sig, &sig2)
+
+	b, err = sigComp.MarshalBinary()
+	require.NoError(t, err)
+	var sigComp2 SignatureComp
+	require.NoError(t, sigComp2.UnmarshalBinary(b))
+	assert.Equal(t, sigComp, sigComp2)
+
+	// Wrong lengths
+	assert.EqualError(t, p.UnmarshalBinary(b),
+		"can't unmarshal []byte of len 64 into Point, want 32")
+	assert.Error(t, pk2.UnmarshalBinary(b))
+	assert.Error(t, comp2.UnmarshalBinary(b))
+	assert.EqualError(t, sig2.UnmarshalBinary(comp[:]),
+		"can't unmarshal []byte of len 32 into Signature, want 64")
+	assert.Error(t, sigComp2.UnmarshalBinary(comp[:]))
+
+	// Invalid contents
+	idComp := NewPoint().Compress()
+	assert.ErrorIs(t, pk2.UnmarshalBinary(idComp[:]), ErrIdentity)
+	assert.ErrorIs(t, comp2.UnmarshalBinary(idComp[:]), ErrIdentity)
+	require.NoError(t, p.UnmarshalBinary(idComp[:]))
+	// The identity and (0, -1) with the sign bit set are non-canonical.
+	minusOne := new(big.Int).Sub(constants.Q, constants.One)
+	for _, q := range []*Point{NewPoint(), {X: big.NewInt(0), Y: minusOne}} {
+		qComp := q.Compress()
+		require.NoError(t, p.UnmarshalBinary(qComp[:]))
+		assert.Equal(t, q, &p)
+		qComp[31] |= 0x80
+		assert.ErrorIs(t, p.UnmarshalBinary(qComp[:]), ErrNonCanonical)
+
+		// Same for R8 in the signature encodings
+		qSig := sig.Compress()
+		copy(qSig[:32], qComp[:])
+		assert.ErrorIs(t, sig2.UnmarshalBinary(qSig[:]), ErrNonCanonical)
+		assert.ErrorIs(t, sigComp2.UnmarshalBinary(qSig[:]), ErrNonCanonical)
+		qSig[31] &^= 0x80
+		require.NoError(t, sig2.UnmarshalBinary(qSig[:]))
+		assert.Equal(t, q, sig2.R8)
+		require.NoError(t, sigComp2.UnmarshalBinary(qSig[:]))
+	}
+
+	badSig := sig.Compress()
+	subOrderLE := PackSignY(false, SubOrder)
+	copy(badSig[32:], subOrderLE[:])
+	assert.ErrorIs(t, sig2.UnmarshalBinary(badSig[:]), ErrNonCanonical)
+	assert.ErrorIs(t, sigComp2.UnmarshalBinary(badSig[:]), ErrNonCanonical)
+}
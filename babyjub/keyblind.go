@@ -0,0 +1,132 @@
+package babyjub
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
+)
+
+// Public key blinding, in the style of the Ed25519 key blinding draft
+// (draft-irtf-cfrg-signature-key-blinding).  A holder of a PrivateKey with
+// public key A = s * B8 and a secret blinding key bk derives, for each
+// context ctx, the blinding factor
+//
+//	f = H(tag || len(bk) || bk || ctx) mod SubOrder
+//
+// and the blinded key pair
+//
+//	s' = f * s mod SubOrder, A' = f * A
+//
+// Blinded public keys for different blinding keys or contexts cannot be
+// linked to each other nor to A without knowing bk.  Signatures made with
+// SignPoseidonBlinded are regular EdDSA-Poseidon signatures that verify with
+// VerifyPoseidon under A'.  Whoever knows bk can recover A from A' with
+// Unblind.
+
+// keyBlindTag is hashed into the blinding factor derivation.
+var keyBlindTag = []byte("babyjub-key-blind")
+
+// keyBlindNonceTag is hashed into the nonce of blinded signatures.
+var keyBlindNonceTag = []byte("babyjub-key-blind-nonce")
+
+// blindingInput returns the encoding len(bk) || bk || ctx of the blinding key
+// and the context.
+func blindingInput(bk, ctx []byte) ([]byte, error) {
+	if len(bk) == 0 {
+		return nil, errors.New("empty blinding key")
+	}
+	if len(bk) > 0xff { //nolint:gomnd
+		return nil, errors.New("blinding key too long")
+	}
+	input := append([]byte{byte(len(bk))}, bk...)
+	return append(input, ctx...), nil
+}
+
+// blindingFactor derives the blinding factor f from the blinding key bk and
+// the context ctx.
+func blindingFactor(bk, ctx []byte) (*scalar.Element, error) {
+	input, err := blindingInput(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	h := Blake512(append(append([]byte{}, keyBlindTag...), input...))
+	f := scalar.NewElement().SetBytesLEWide(h)
+	if f.IsZero() {
+		return nil, errors.New("zero blinding factor")
+	}
+	return f, nil
+}
+
+// Blind returns the blinded public key A' = f * A, where A is pk and f is the
+// blinding factor derived from the blinding key bk and the context ctx.
+// Returns error if pk is not a valid public key.
+func (pk *PublicKey) Blind(bk, ctx []byte) (*PublicKey, error) {
+	if err := pk.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	f, err := blindingFactor(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := NewPoint().MulScalar(f, pk.Point())
+	return (*PublicKey)(p), nil
+}
+
+// Unblind returns the public key A = f^-1 * A' from which the blinded public
+// key pk was derived with the blinding key bk and the context ctx.  Returns
+// error if pk is not a valid public key.
+func (pk *PublicKey) Unblind(bk, ctx []byte) (*PublicKey, error) {
+	if err := pk.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	f, err := blindingFactor(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	f.Inverse(f)
+	p := NewPoint().MulScalar(f, pk.Point())
+	return (*PublicKey)(p), nil
+}
+
+// BlindScalar returns the blinded private scalar s' = f * s mod SubOrder
+// matching the blinded public key k.Public().Blind(bk, ctx).
+func (k *PrivateKey) BlindScalar(bk, ctx []byte) (*PrivKeyScalar, error) {
+	f, err := blindingFactor(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	f.Mul(f, k.Scalar().Element())
+	return NewPrivKeyScalarFromElement(f), nil
+}
+
+// SignPoseidonBlinded signs a message encoded as a big.Int in Zq with the
+// blinded private scalar derived from the blinding key bk and the context
+// ctx.  The signature verifies with VerifyPoseidon under the blinded public
+// key k.Public().Blind(bk, ctx).  The nonce is derived deterministically from
+// the private key, bk, ctx and msg, and is independent of the nonces of
+// unblinded signatures of the same message.
+func (k *PrivateKey) SignPoseidonBlinded(bk, ctx []byte, msg *big.Int) (*Signature, error) {
+	s, err := k.BlindScalar(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	A := s.Public().Point()
+	input, err := blindingInput(bk, ctx)
+	if err != nil {
+		return nil, err
+	}
+	aux := append(append([]byte{}, keyBlindNonceTag...), Blake512(input)...)
+	r, err := k.nonce(msg, nil, aux)
+	if err != nil {
+		return nil, err
+	}
+	R8 := NewPoint().MulScalar(r, B8) // R8 = r * 8 * B
+	hm, err := challengePoseidon(R8, A, msg)
+	if err != nil {
+		return nil, err
+	}
+	S := signResponse(r, hm, s) // S = r + hm * 8 * s'
+	return &Signature{R8: R8, S: S}, nil
+}
@@ -0,0 +1,63 @@
+package babyjub
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyBlinding(t *testing.T) {
+	var k PrivateKey
+	for i := 0; i < 32; i++ {
+		k[i] = byte(i)
+	}
+	pk := k.Public()
+	bk := []byte("blinding key")
+	msg := big.NewInt(42)
+
+	pkA, err := pk.Blind(bk, []byte("verifier A"))
+	require.NoError(t, err)
+	pkB, err := pk.Blind(bk, []byte("verifier B"))
+	require.NoError(t, err)
+	assert.NotEqual(t, pk, pkA)
+	assert.NotEqual(t, pkA, pkB)
+	require.NoError(t, pkA.Validate())
+
+	// The blinded scalar matches the blinded public key
+	sA, err := k.BlindScalar(bk, []byte("verifier A"))
+	require.NoError(t, err)
+	assert.Equal(t, pkA, sA.Public())
+
+	// Blinded signatures verify under the blinded key only
+	sig, err := k.SignPoseidonBlinded(bk, []byte("verifier A"), msg)
+	require.NoError(t, err)
+	require.NoError(t, pkA.VerifyPoseidon(msg, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pkB.VerifyPoseidon(msg, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(msg, sig))
+	assert.Equal(t, ErrVerifyPoseidonFailed, pkA.VerifyPoseidon(big.NewInt(43), sig))
+
+	// Deterministic, and independent of the unblinded nonce
+	sig2, err := k.SignPoseidonBlinded(bk, []byte("verifier A"), msg)
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+	sig3, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+	assert.NotEqual(t, sig3.R8, sig.R8)
+
+	// Unblinding recovers the original key
+	pk2, err := pkA.Unblind(bk, []byte("verifier A"))
+	require.NoError(t, err)
+	assert.Equal(t, pk, pk2)
+
+	// Invalid inputs
+	_, err = pk.Blind(nil, []byte("verifier A"))
+	assert.Error(t, err)
+	_, err = pk.Blind(make([]byte, 256), nil)
+	assert.Error(t, err)
+	_, err = (*PublicKey)(NewPoint()).Blind(bk, nil)
+	assert.ErrorIs(t, err, ErrIdentity)
+	_, err = k.SignPoseidonBlinded(nil, nil, msg)
+	assert.Error(t, err)
+}
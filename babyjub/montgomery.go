@@ -0,0 +1,185 @@
+package babyjub
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+)
+
+// MontA is the A coefficient of the babyjub curve in Montgomery form
+// B*v^2 = u^3 + A*u^2 + u, with A = 2 * (a + d) / (a - d).
+var MontA = big.NewInt(168698) //nolint:gomnd
+
+// MontB is the B coefficient of the babyjub curve in Montgomery form
+// B*v^2 = u^3 + A*u^2 + u, with B = 4 / (a - d).
+var MontB = big.NewInt(1)
+
+// montA24 is (MontA + 2) / 4, used in the Montgomery ladder.
+var montA24 = ff.NewElement().SetUint64(42175) //nolint:gomnd
+
+// PointMontgomery represents a point of the babyjub curve in Montgomery form.
+// The point at infinity, which corresponds to the identity (0, 1) in twisted
+// Edwards form, is represented with Infinity set to true.
+type PointMontgomery struct {
+	U        *big.Int
+	V        *big.Int
+	Infinity bool
+}
+
+// Montgomery returns the Point p in Montgomery form, using the birational map
+// u = (1 + y) / (1 - y), v = u / x.  The identity maps to the point at
+// infinity and (0, -1) maps to (0, 0).  Returns an error wrapping
+// ErrNotOnCurve if p is not in the curve.
+func (p *Point) Montgomery() (*PointMontgomery, error) {
+	if !checkCoords(p.X, p.Y) || !p.InCurve() {
+		return nil, fmt.Errorf("%w: edwards point", ErrNotOnCurve)
+	}
+	if p.X.Sign() == 0 {
+		if p.Y.Cmp(constants.One) == 0 {
+			return &PointMontgomery{U: big.NewInt(0), V: big.NewInt(0), Infinity: true}, nil
+		}
+		return &PointMontgomery{U: big.NewInt(0), V: big.NewInt(0)}, nil
+	}
+	// The only points of the curve with y = 1 or x = 0 are (0, 1) and
+	// (0, -1), so the inverses below exist.
+	num := new(big.Int).Add(constants.One, p.Y)
+	den := new(big.Int).Sub(constants.One, p.Y)
+	den.Mod(den, constants.Q)
+	den.ModInverse(den, constants.Q)
+	u := num.Mul(num, den)
+	u.Mod(u, constants.Q)
+
+	xInv := new(big.Int).ModInverse(p.X, constants.Q)
+	v := new(big.Int).Mul(u, xInv)
+	v.Mod(v, constants.Q)
+	return &PointMontgomery{U: u, V: v}, nil
+}
+
+// InCurve returns true when the PointMontgomery p is in the babyjub curve in
+// Montgomery form.
+func (p *PointMontgomery) InCurve() bool {
+	if p.Infinity {
+		return true
+	}
+	if !checkCoords(p.U, p.V) {
+		return false
+	}
+	// B*v^2
+	left := new(big.Int).Mul(p.V, p.V)
+	left.Mul(left, MontB)
+	left.Mod(left, constants.Q)
+	// u^3 + A*u^2 + u = u * (u * (u + A) + 1)
+	right := new(big.Int).Add(p.U, MontA)
+	right.Mul(right, p.U)
+	right.Add(right, constants.One)
+	right.Mul(right, p.U)
+	right.Mod(right, constants.Q)
+	return left.Cmp(right) == 0
+}
+
+// Edwards returns the Point in twisted Edwards form corresponding to the
+// PointMontgomery p, using the birational map x = u / v, y = (u - 1) / (u + 1).
+// Returns an error wrapping ErrNotOnCurve if p is not in the curve.
+func (p *PointMontgomery) Edwards() (*Point, error) {
+	if !p.InCurve() {
+		return nil, fmt.Errorf("%w: montgomery point", ErrNotOnCurve)
+	}
+	if p.Infinity {
+		return NewPoint(), nil
+	}
+	if p.U.Sign() == 0 {
+		return &Point{X: big.NewInt(0), Y: new(big.Int).Sub(constants.Q, constants.One)}, nil
+	}
+	// Since d is not a square, the curve has no other points with v = 0 or
+	// u = -1, so the inverses below exist.
+	vInv := new(big.Int).ModInverse(p.V, constants.Q)
+	x := new(big.Int).Mul(p.U, vInv)
+	x.Mod(x, constants.Q)
+
+	num := new(big.Int).Sub(p.U, constants.One)
+	den := new(big.Int).Add(p.U, constants.One)
+	den.ModInverse(den, constants.Q)
+	y := num.Mul(num, den)
+	y.Mod(y, constants.Q)
+	return &Point{X: x, Y: y}, nil
+}
+
+// checkCoords returns true when all the coordinates are inside the finite
+// field.
+func checkCoords(cs ...*big.Int) bool {
+	for _, c := range cs {
+		if c == nil || c.Sign() < 0 || c.Cmp(constants.Q) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MontgomeryLadder computes the u coordinate of s * P, where u is the u
+// coordinate of a point P of the babyjub curve in Montgomery form, using the
+// x-only Montgomery ladder.  Since u(P) = u(-P), the sign of s is ignored.
+// Returns an error wrapping ErrIdentity when the result is the point at
+// infinity, and an error wrapping ErrNonCanonical if u is not inside the
+// finite field.  This method does not check that u corresponds to a point in
+// the curve: if it does not, the result is the u coordinate of a point in
+// the quadratic twist.
+func MontgomeryLadder(s, u *big.Int) (*big.Int, error) {
+	if !checkCoords(u) {
+		return nil, fmt.Errorf("%w: u not inside Finite Field", ErrNonCanonical)
+	}
+	x1 := ff.NewElement().SetBigInt(u)
+	x2 := ff.NewElement().SetOne()
+	z2 := ff.NewElement().SetZero()
+	x3 := ff.NewElement().Set(x1)
+	z3 := ff.NewElement().SetOne()
+
+	a := ff.NewElement()
+	aa := ff.NewElement()
+	b := ff.NewElement()
+	bb := ff.NewElement()
+	e := ff.NewElement()
+	c := ff.NewElement()
+	d := ff.NewElement()
+	da := ff.NewElement()
+	cb := ff.NewElement()
+
+	k := new(big.Int).Abs(s)
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		if k.Bit(i) == 1 {
+			x2, x3 = x3, x2
+			z2, z3 = z3, z2
+		}
+		// RFC 7748, section 5
+		a.Add(x2, z2)
+		aa.Square(a)
+		b.Sub(x2, z2)
+		bb.Square(b)
+		e.Sub(aa, bb)
+		c.Add(x3, z3)
+		d.Sub(x3, z3)
+		da.Mul(d, a)
+		cb.Mul(c, b)
+		x3.Add(da, cb)
+		x3.Square(x3)
+		z3.Sub(da, cb)
+		z3.Square(z3)
+		z3.Mul(z3, x1)
+		x2.Mul(aa, bb)
+		z2.Mul(montA24, e)
+		z2.Add(z2, bb)
+		z2.Mul(z2, e)
+		if k.Bit(i) == 1 {
+			x2, x3 = x3, x2
+			z2, z3 = z3, z2
+		}
+	}
+
+	if z2.IsZero() {
+		return nil, ErrIdentity
+	}
+	z2.Inverse(z2)
+	x2.Mul(x2, z2)
+	return x2.ToBigIntRegular(new(big.Int)), nil
+}
@@ -0,0 +1,107 @@
+package babyjub
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMontgomeryCoefficients(t *testing.T) {
+	// A = 2 * (a + d) / (a - d), B = 4 / (a - d)
+	aMinusD := new(big.Int).Sub(A, D)
+	aMinusDInv := new(big.Int).ModInverse(aMinusD, constants.Q)
+	montA := new(big.Int).Add(A, D)
+	montA.Lsh(montA, 1)
+	montA.Mul(montA, aMinusDInv)
+	montA.Mod(montA, constants.Q)
+	assert.Equal(t, MontA, montA)
+	montB := new(big.Int).Mul(big.NewInt(4), aMinusDInv)
+	montB.Mod(montB, constants.Q)
+	assert.Equal(t, MontB, montB)
+}
+
+func TestMontgomeryEdwards(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		p := NewPoint().Mul(big.NewInt(int64(i*i+7)), B8)
+		pm, err := p.Montgomery()
+		require.NoError(t, err)
+		assert.True(t, pm.InCurve())
+		p2, err := pm.Edwards()
+		require.NoError(t, err)
+		assert.Equal(t, p, p2)
+	}
+
+	// identity <-> point at infinity
+	pm, err := NewPoint().Montgomery()
+	require.NoError(t, err)
+	assert.True(t, pm.Infinity)
+	p, err := pm.Edwards()
+	require.NoError(t, err)
+	assert.Equal(t, NewPoint(), p)
+
+	// (0, -1) <-> (0, 0)
+	minusOne := new(big.Int).Sub(constants.Q, constants.One)
+	pm, err = (&Point{X: big.NewInt(0), Y: minusOne}).Montgomery()
+	require.NoError(t, err)
+	assert.False(t, pm.Infinity)
+	assert.Equal(t, &PointMontgomery{U: big.NewInt(0), V: big.NewInt(0)}, pm)
+	assert.True(t, pm.InCurve())
+	p, err = pm.Edwards()
+	require.NoError(t, err)
+	assert.Equal(t, &Point{X: big.NewInt(0), Y: minusOne}, p)
+
+	// Not in the curve
+	for _, q := range []*Point{
+		{X: big.NewInt(5), Y: big.NewInt(1)}, // y = 1, x != 0
+		{X: big.NewInt(0), Y: big.NewInt(2)},
+		{X: big.NewInt(1), Y: big.NewInt(1)},
+		{X: constants.Q, Y: big.NewInt(1)},
+	} {
+		_, err = q.Montgomery()
+		assert.ErrorIs(t, err, ErrNotOnCurve)
+	}
+	_, err = (&PointMontgomery{U: big.NewInt(1), V: big.NewInt(1)}).Edwards()
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+	_, err = (&PointMontgomery{U: constants.Q, V: big.NewInt(0)}).Edwards()
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+}
+
+func TestMontgomeryLadder(t *testing.T) {
+	b8, err := B8.Montgomery()
+	require.NoError(t, err)
+	u := b8.U
+	for i := 1; i < 32; i++ {
+		s := new(big.Int).Exp(big.NewInt(int64(i)), big.NewInt(40), SubOrder)
+		expected, err := NewPoint().Mul(s, B8).Montgomery()
+		require.NoError(t, err)
+		got, err := MontgomeryLadder(s, u)
+		require.NoError(t, err)
+		assert.Equal(t, expected.U, got)
+		got, err = MontgomeryLadder(new(big.Int).Neg(s), u)
+		require.NoError(t, err)
+		assert.Equal(t, expected.U, got)
+	}
+
+	// ECDH
+	a := big.NewInt(1234567)
+	b := big.NewInt(7654321)
+	uA, err := MontgomeryLadder(a, u)
+	require.NoError(t, err)
+	uB, err := MontgomeryLadder(b, u)
+	require.NoError(t, err)
+	sharedA, err := MontgomeryLadder(a, uB)
+	require.NoError(t, err)
+	sharedB, err := MontgomeryLadder(b, uA)
+	require.NoError(t, err)
+	assert.Equal(t, sharedA, sharedB)
+
+	_, err = MontgomeryLadder(SubOrder, u)
+	assert.ErrorIs(t, err, ErrIdentity)
+	_, err = MontgomeryLadder(big.NewInt(0), u)
+	assert.ErrorIs(t, err, ErrIdentity)
+	_, err = MontgomeryLadder(big.NewInt(1), constants.Q)
+	assert.ErrorIs(t, err, ErrNonCanonical)
+}
@@ -0,0 +1,147 @@
+package babyjub
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
+	"github.com/iden3/go-iden3-crypto/v2/poseidon"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// Deterministic nullifiers in the style of PLUME (ERC-7524), on the babyjub
+// curve and with Poseidon as the hash function, so that the verification can
+// also be done in a circuit.  For a private scalar s with public key
+// A = s * B8 and an application message msg:
+//
+//	H = HashToCurve(msg, A)
+//	N = s * H
+//	R1 = r * B8, R2 = r * H
+//	c = Poseidon(B8.x, B8.y, A.x, A.y, H.x, H.y, N.x, N.y, R1.x, R1.y, R2.x, R2.y)
+//	z = r + c * s mod SubOrder
+//
+// N is the nullifier: it is the same every time the key is used with the same
+// message, and nullifiers of different messages cannot be linked to each
+// other nor to A.  (c, z) is a proof that log_B8(A) = log_H(N).  The verifier
+// recomputes R1 = z * B8 - c * A and R2 = z * H - c * N and checks c.
+
+// ErrVerifyNullifierFailed is returned when the proof of a nullifier does not
+// verify.
+var ErrVerifyNullifierFailed = errors.New("verifyNullifier failed")
+
+// nullifierHashDomain is the first input of the Poseidon hash used by
+// HashToCurve, separating it from other uses of Poseidon.
+var nullifierHashDomain = new(big.Int).SetBytes([]byte("babyjub-nullifier-h2c"))
+
+// nullifierNonceTag is hashed into the nonce of the nullifier proofs.
+var nullifierNonceTag = []byte("babyjub-nullifier")
+
+// hashToCurveMaxTries is the number of counter values tried by HashToCurve.
+// Each try succeeds with probability close to 1/2.
+const hashToCurveMaxTries = 256
+
+// HashToCurve hashes the message msg, encoded as a big.Int in Zq, and the
+// public key pk to a point of the subgroup, using try-and-increment:
+//
+//	y = Poseidon(domain, msg, A.x, A.y, ctr)
+//	H = 8 * (x, y)
+//
+// for the first counter ctr = 0, 1, ... for which y is the y coordinate of a
+// curve point (x, y) with non-negative x and H is not the identity.  In a
+// circuit, ctr and x are given as hints.
+func HashToCurve(msg *big.Int, pk *PublicKey) (*Point, error) {
+	if !utils.CheckBigIntInField(msg) {
+		return nil, errors.New("message not inside the Finite Field")
+	}
+	for ctr := int64(0); ctr < hashToCurveMaxTries; ctr++ {
+		y, err := poseidon.Hash([]*big.Int{nullifierHashDomain, msg, pk.X, pk.Y,
+			big.NewInt(ctr)})
+		if err != nil {
+			return nil, err
+		}
+		p, err := PointFromSignAndY(false, y)
+		if err != nil {
+			continue
+		}
+		h := NewPoint().Mul(big.NewInt(8), p) //nolint:gomnd
+		if h.X.Sign() != 0 {
+			return h, nil
+		}
+	}
+	return nil, errors.New("hash to curve failed")
+}
+
+// Nullifier is a PLUME-style nullifier together with the proof that it was
+// computed with the private key of a public key.
+type Nullifier struct {
+	N *Point
+	C *big.Int
+	Z *big.Int
+}
+
+// nullifierChallenge computes the challenge c of a nullifier proof.
+func nullifierChallenge(A, H, N, R1, R2 *Point) (*big.Int, error) {
+	return poseidon.Hash([]*big.Int{B8.X, B8.Y, A.X, A.Y, H.X, H.Y,
+		N.X, N.Y, R1.X, R1.Y, R2.X, R2.Y})
+}
+
+// NewNullifier computes the nullifier of the message msg, encoded as a big.Int
+// in Zq, and its proof.  The nonce of the proof is derived deterministically
+// from the private key and msg.
+func (k *PrivateKey) NewNullifier(msg *big.Int) (*Nullifier, error) {
+	s := k.Scalar().Element()
+	A := k.Public().Point()
+	H, err := HashToCurve(msg, k.Public())
+	if err != nil {
+		return nil, err
+	}
+	N := NewPoint().MulScalar(s, H)
+
+	r, err := k.nonce(msg, nil, nullifierNonceTag)
+	if err != nil {
+		return nil, err
+	}
+	R1 := NewPoint().MulScalar(r, B8)
+	R2 := NewPoint().MulScalar(r, H)
+	c, err := nullifierChallenge(A, H, N, R1, R2)
+	if err != nil {
+		return nil, err
+	}
+	z := scalar.NewElement().SetBigInt(c)
+	z.Mul(z, s)
+	z.Add(z, r) // z = r + c * s
+
+	return &Nullifier{N: N, C: c, Z: z.BigInt()}, nil
+}
+
+// VerifyNullifier verifies that the nullifier n of the message msg, encoded
+// as a big.Int in Zq, was computed with the private key of pk.
+func (pk *PublicKey) VerifyNullifier(msg *big.Int, n *Nullifier) error {
+	if err := pk.Validate(); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+	if err := n.N.checkSubGroup(); err != nil {
+		return fmt.Errorf("invalid nullifier: %w", err)
+	}
+	if !utils.CheckBigIntInField(n.C) || n.Z.Sign() < 0 || n.Z.Cmp(SubOrder) >= 0 {
+		return ErrVerifyNullifierFailed
+	}
+	A := pk.Point()
+	H, err := HashToCurve(msg, pk)
+	if err != nil {
+		return err
+	}
+	// R1 = z * B8 - c * A, R2 = z * H - c * N
+	R1 := doubleMul(n.Z, b8Table, n.C, newNegPointTable(A)).Affine()
+	R2 := doubleMul(n.Z, newPointTable(H.Projective()), n.C,
+		newNegPointTable(n.N)).Affine()
+	c, err := nullifierChallenge(A, H, n.N, R1, R2)
+	if err != nil {
+		return err
+	}
+	if c.Cmp(n.C) != 0 {
+		return ErrVerifyNullifierFailed
+	}
+	return nil
+}
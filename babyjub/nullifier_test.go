@@ -0,0 +1,73 @@
+package babyjub
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashToCurve(t *testing.T) {
+	var k PrivateKey
+	for i := 0; i < 32; i++ {
+		k[i] = byte(i)
+	}
+	pk := k.Public()
+	H, err := HashToCurve(big.NewInt(42), pk)
+	require.NoError(t, err)
+	require.NoError(t, H.checkSubGroup())
+	H2, err := HashToCurve(big.NewInt(42), pk)
+	require.NoError(t, err)
+	assert.Equal(t, H, H2)
+	H3, err := HashToCurve(big.NewInt(43), pk)
+	require.NoError(t, err)
+	assert.NotEqual(t, H, H3)
+
+	_, err = HashToCurve(new(big.Int).Neg(big.NewInt(1)), pk)
+	assert.Error(t, err)
+}
+
+func TestNullifier(t *testing.T) {
+	var k PrivateKey
+	for i := 0; i < 32; i++ {
+		k[i] = byte(i)
+	}
+	pk := k.Public()
+	msg := big.NewInt(42)
+
+	n, err := k.NewNullifier(msg)
+	require.NoError(t, err)
+	require.NoError(t, pk.VerifyNullifier(msg, n))
+
+	// Deterministic for the same key and message
+	n2, err := k.NewNullifier(msg)
+	require.NoError(t, err)
+	assert.Equal(t, n.N, n2.N)
+
+	// Different for other messages and keys
+	n3, err := k.NewNullifier(big.NewInt(43))
+	require.NoError(t, err)
+	assert.NotEqual(t, n.N, n3.N)
+	k2 := k
+	k2[0]++
+	n4, err := k2.NewNullifier(msg)
+	require.NoError(t, err)
+	assert.NotEqual(t, n.N, n4.N)
+
+	// The proof is tied to the key, the message and the nullifier
+	assert.Equal(t, ErrVerifyNullifierFailed, k2.Public().VerifyNullifier(msg, n))
+	assert.Equal(t, ErrVerifyNullifierFailed, pk.VerifyNullifier(big.NewInt(43), n))
+	bad := *n
+	bad.N = n3.N
+	assert.Equal(t, ErrVerifyNullifierFailed, pk.VerifyNullifier(msg, &bad))
+	bad = *n
+	bad.Z = new(big.Int).Add(n.Z, big.NewInt(1))
+	assert.Equal(t, ErrVerifyNullifierFailed, pk.VerifyNullifier(msg, &bad))
+	bad = *n
+	bad.Z = new(big.Int).Add(n.Z, SubOrder)
+	assert.Equal(t, ErrVerifyNullifierFailed, pk.VerifyNullifier(msg, &bad))
+	bad = *n
+	bad.N = NewPoint()
+	assert.ErrorIs(t, pk.VerifyNullifier(msg, &bad), ErrIdentity)
+}
@@ -0,0 +1,69 @@
+package babyjub
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreparedPublicKey(t *testing.T) {
+	var k PrivateKey
+	_, err := hex.Decode(k[:],
+		[]byte("0001020304050607080900010203040506070809000102030405060708090001"))
+	require.NoError(t, err)
+	pk := k.Public()
+	ppk, err := pk.Prepare()
+	require.NoError(t, err)
+	assert.Equal(t, pk, ppk.PublicKey())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			msg := big.NewInt(int64(i))
+			sigP, err := k.SignPoseidon(msg)
+			require.NoError(t, err)
+			sigM, err := k.SignMimc7(msg)
+			require.NoError(t, err)
+
+			assert.NoError(t, ppk.VerifyPoseidon(msg, sigP))
+			assert.NoError(t, ppk.VerifyMimc7(msg, sigM))
+
+			wrong := big.NewInt(int64(i + 100))
+			assert.Equal(t, pk.VerifyPoseidon(wrong, sigP), ppk.VerifyPoseidon(wrong, sigP))
+			assert.Equal(t, ErrVerifyPoseidonFailed, ppk.VerifyPoseidon(wrong, sigP))
+			assert.Equal(t, pk.VerifyMimc7(wrong, sigM), ppk.VerifyMimc7(wrong, sigM))
+			assert.Equal(t, ErrVerifyMimc7Failed, ppk.VerifyMimc7(wrong, sigM))
+		}(i)
+	}
+	wg.Wait()
+
+	// Same results as the PublicKey for non reduced or negative S
+	msg := big.NewInt(7)
+	sig, err := k.SignPoseidon(msg)
+	require.NoError(t, err)
+	sigL := &Signature{R8: sig.R8, S: new(big.Int).Add(sig.S, SubOrder)}
+	assert.NoError(t, pk.VerifyPoseidon(msg, sigL))
+	assert.NoError(t, ppk.VerifyPoseidon(msg, sigL))
+	sigNeg := &Signature{R8: sig.R8, S: new(big.Int).Sub(sig.S, SubOrder)}
+	assert.Equal(t, ErrVerifyPoseidonFailed, pk.VerifyPoseidon(msg, sigNeg))
+	assert.Equal(t, ErrVerifyPoseidonFailed, ppk.VerifyPoseidon(msg, sigNeg))
+
+	// Invalid keys are rejected when preparing them
+	_, err = NewPreparedPublicKey((*PublicKey)(NewPoint()))
+	assert.ErrorIs(t, err, ErrIdentity)
+	notSub := &PublicKey{
+		X: new(big.Int).Sub(constants.Q, pk.X),
+		Y: new(big.Int).Sub(constants.Q, pk.Y),
+	}
+	_, err = notSub.Prepare()
+	assert.ErrorIs(t, err, ErrNotInSubgroup)
+	_, err = (&PublicKey{X: big.NewInt(1), Y: big.NewInt(1)}).Prepare()
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+}
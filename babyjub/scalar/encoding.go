@@ -0,0 +1,75 @@
+package scalar
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+)
+
+// The functions in this file are not generated.  They provide the
+// little-endian encodings used by babyjub (compressed points and signatures
+// store S as a 32 byte little-endian integer), and the reduction of wide
+// hash outputs into scalars.
+
+// ErrNonCanonical is returned when decoding an encoding of an integer that is
+// not lower than the modulus.
+var ErrNonCanonical = errors.New("non-canonical scalar encoding")
+
+// reverse returns a copy of b with the bytes in reverse order.
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+// BytesLE returns the regular (non montgomery) value of z as a 32 byte
+// little-endian array.  This is the canonical encoding of z.
+func (z *Element) BytesLE() (res [Bytes]byte) {
+	be := z.Bytes()
+	for i := range be {
+		res[Bytes-1-i] = be[i]
+	}
+	return
+}
+
+// SetBytesLECanonical sets z to the value of the 32 byte little-endian
+// integer e, and returns z.  Returns ErrNonCanonical if e is not lower than
+// the modulus.
+func (z *Element) SetBytesLECanonical(e [Bytes]byte) (*Element, error) {
+	vv := bigIntPool.Get().(*big.Int)
+	defer bigIntPool.Put(vv)
+	vv.SetBytes(reverse(e[:]))
+	if vv.Cmp(&_modulus) >= 0 {
+		return nil, ErrNonCanonical
+	}
+	return z.setBigInt(vv), nil
+}
+
+// SetBytesLEWide sets z to the little-endian integer e reduced modulo q, and
+// returns z.  e may have any length; it is meant to reduce the 64 byte
+// output of a hash function into a scalar with negligible bias.
+func (z *Element) SetBytesLEWide(e []byte) *Element {
+	return z.SetBytes(reverse(e))
+}
+
+// SetRandomFrom sets z to a uniformly random element < q, reading 64 bytes
+// from rnd so that the modulo bias is negligible, and returns z.  If rnd is
+// nil, crypto/rand.Reader is used.
+func (z *Element) SetRandomFrom(rnd io.Reader) (*Element, error) {
+	if rnd == nil {
+		rnd = rand.Reader
+	}
+	var buf [2 * Bytes]byte
+	if _, err := io.ReadFull(rnd, buf[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytesLEWide(buf[:]), nil
+}
+
+// BigInt returns the regular (non montgomery) value of z as a new big.Int.
+func (z *Element) BigInt() *big.Int {
+	return z.ToBigIntRegular(new(big.Int))
+}
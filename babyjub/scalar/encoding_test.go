@@ -0,0 +1,69 @@
+package scalar
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBytesLE(t *testing.T) {
+	var z Element
+	z.SetUint64(0x0102)
+	buf := z.BytesLE()
+	assert.Equal(t, byte(0x02), buf[0])
+	assert.Equal(t, byte(0x01), buf[1])
+
+	var z2 Element
+	_, err := z2.SetBytesLECanonical(buf)
+	require.NoError(t, err)
+	assert.True(t, z.Equal(&z2))
+
+	// q - 1 is canonical, q is not
+	qm1 := new(big.Int).Sub(Modulus(), big.NewInt(1))
+	z.SetBigInt(qm1)
+	buf = z.BytesLE()
+	_, err = z2.SetBytesLECanonical(buf)
+	require.NoError(t, err)
+	assert.Equal(t, qm1, z2.BigInt())
+	buf[0]++
+	_, err = z2.SetBytesLECanonical(buf)
+	assert.Equal(t, ErrNonCanonical, err)
+}
+
+func TestSetBytesLEWide(t *testing.T) {
+	wide := make([]byte, 64)
+	for i := range wide {
+		wide[i] = byte(i * 7)
+	}
+	var z Element
+	z.SetBytesLEWide(wide)
+
+	be := make([]byte, len(wide))
+	for i := range wide {
+		be[len(wide)-1-i] = wide[i]
+	}
+	expected := new(big.Int).SetBytes(be)
+	expected.Mod(expected, Modulus())
+	assert.Equal(t, expected, z.BigInt())
+}
+
+func TestSetRandomFrom(t *testing.T) {
+	rnd := bytes.NewReader(bytes.Repeat([]byte{0xff}, 64))
+	var z Element
+	_, err := z.SetRandomFrom(rnd)
+	require.NoError(t, err)
+	expected := new(big.Int).Lsh(big.NewInt(1), 512)
+	expected.Sub(expected, big.NewInt(1))
+	expected.Mod(expected, Modulus())
+	assert.Equal(t, expected, z.BigInt())
+
+	// not enough randomness
+	_, err = z.SetRandomFrom(bytes.NewReader(make([]byte, 63)))
+	assert.Error(t, err)
+
+	_, err = z.SetRandomFrom(nil)
+	require.NoError(t, err)
+}
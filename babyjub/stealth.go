@@ -0,0 +1,219 @@
+package babyjub
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/babyjub/scalar"
+	"github.com/iden3/go-iden3-crypto/v2/keccak256"
+	"github.com/iden3/go-iden3-crypto/v2/poseidon"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// Stealth addresses in the style of ERC-5564, on the babyjub curve.  A
+// recipient publishes a StealthMetaAddress made of a spending public key
+// K_s = k_s * B8 and a viewing public key K_v = k_v * B8.  To pay the
+// recipient, a sender picks an ephemeral scalar r and computes:
+//
+//	R = r * B8
+//	S = r * K_v
+//	h = Poseidon(S.x, S.y)
+//	P = K_s + (h mod SubOrder) * B8
+//
+// and publishes the ephemeral public key R together with the view tag, the
+// first byte of Keccak256(S.x || S.y), with the coordinates as 32-byte
+// big-endian integers, which is uniformly distributed, like the first byte of
+// the hashed shared secret of ERC-5564.  P is the one-time public key, which an
+// observer cannot link to the meta address.  The recipient computes
+// S = k_v * R to find its payments, discarding most of the others by
+// comparing the view tag only, recovers the one-time private scalar
+// p = k_s + (h mod SubOrder) with p * B8 = P, and signs with it.
+
+// stealthNonceTag is hashed into the nonce of the signatures made with a
+// one-time stealth private scalar.
+var stealthNonceTag = []byte("babyjub-stealth")
+
+// ErrStealthAddressMismatch is returned when a stealth address does not
+// belong to the stealth keys.
+var ErrStealthAddressMismatch = errors.New("stealth address does not match")
+
+// StealthMetaAddress is the public information a recipient publishes to
+// receive payments at stealth addresses.
+type StealthMetaAddress struct {
+	SpendingKey *PublicKey
+	ViewingKey  *PublicKey
+}
+
+// StealthAddress is a one-time stealth address generated by a sender for a
+// StealthMetaAddress.  PublicKey is the one-time public key of the
+// recipient, and EphemeralKey and ViewTag are published next to it so that
+// the recipient can find it.
+type StealthAddress struct {
+	PublicKey    *PublicKey
+	EphemeralKey *PublicKey
+	ViewTag      byte
+}
+
+// stealthSharedSecret returns the hash h = Poseidon(S.x, S.y) of the shared
+// point S, reduced modulo SubOrder, and the view tag, the first byte of
+// Keccak256(S.x || S.y).
+func stealthSharedSecret(S *Point) (*big.Int, byte, error) {
+	h, err := poseidon.Hash([]*big.Int{S.X, S.Y})
+	if err != nil {
+		return nil, 0, err
+	}
+	var x, y [32]byte
+	S.X.FillBytes(x[:])
+	S.Y.FillBytes(y[:])
+	viewTag := keccak256.Hash(x[:], y[:])[0]
+	return h.Mod(h, SubOrder), viewTag, nil
+}
+
+// NewStealthAddress generates a new one-time stealth address for the meta
+// address m.  The ephemeral scalar is read from rnd.  If rnd is nil,
+// crypto/rand.Reader is used.
+func (m *StealthMetaAddress) NewStealthAddress(rnd io.Reader) (*StealthAddress, error) {
+	if err := m.SpendingKey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spending key: %w", err)
+	}
+	if err := m.ViewingKey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid viewing key: %w", err)
+	}
+	r, err := randScalar(rnd)
+	if err != nil {
+		return nil, err
+	}
+	if r.Sign() == 0 {
+		return nil, errors.New("zero ephemeral scalar")
+	}
+	R := NewPoint().Mul(r, B8)                   // R = r * B8
+	S := NewPoint().Mul(r, m.ViewingKey.Point()) // S = r * K_v
+	hs, viewTag, err := stealthSharedSecret(S)
+	if err != nil {
+		return nil, err
+	}
+	P := addPoints(m.SpendingKey.Point(), NewPoint().Mul(hs, B8)) // P = K_s + hs * B8
+	return &StealthAddress{
+		PublicKey:    (*PublicKey)(P),
+		EphemeralKey: (*PublicKey)(R),
+		ViewTag:      viewTag,
+	}, nil
+}
+
+// StealthViewingKey allows to find the stealth addresses of a recipient
+// without being able to spend from them.
+type StealthViewingKey struct {
+	ViewingKey  *PrivKeyScalar
+	SpendingKey *PublicKey
+}
+
+// hashedSecret returns the reduced hashed shared secret of the stealth
+// address, or ErrStealthAddressMismatch if the view tag or the one-time
+// public key do not match.
+func (v *StealthViewingKey) hashedSecret(addr *StealthAddress) (*big.Int, error) {
+	if err := addr.EphemeralKey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid ephemeral key: %w", err)
+	}
+	S := NewPoint().Mul(v.ViewingKey.BigInt(), addr.EphemeralKey.Point()) // S = k_v * R
+	hs, viewTag, err := stealthSharedSecret(S)
+	if err != nil {
+		return nil, err
+	}
+	if viewTag != addr.ViewTag {
+		return nil, ErrStealthAddressMismatch
+	}
+	P := addPoints(v.SpendingKey.Point(), NewPoint().Mul(hs, B8))
+	if P.X.Cmp(addr.PublicKey.X) != 0 || P.Y.Cmp(addr.PublicKey.Y) != 0 {
+		return nil, ErrStealthAddressMismatch
+	}
+	return hs, nil
+}
+
+// Check returns true when the stealth address belongs to the recipient.
+// Addresses with a different view tag are discarded without computing the
+// one-time public key.
+func (v *StealthViewingKey) Check(addr *StealthAddress) (bool, error) {
+	_, err := v.hashedSecret(addr)
+	if errors.Is(err, ErrStealthAddressMismatch) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// StealthKeys holds the private spending and viewing keys of a stealth
+// address recipient.
+type StealthKeys struct {
+	SpendingKey *PrivKeyScalar
+	ViewingKey  *PrivKeyScalar
+}
+
+// NewStealthKeys returns the StealthKeys made of the scalars of the spending
+// and viewing private keys.
+func NewStealthKeys(spending, viewing *PrivateKey) *StealthKeys {
+	return &StealthKeys{SpendingKey: spending.Scalar(), ViewingKey: viewing.Scalar()}
+}
+
+// MetaAddress returns the StealthMetaAddress to publish for the keys.
+func (k *StealthKeys) MetaAddress() *StealthMetaAddress {
+	return &StealthMetaAddress{
+		SpendingKey: k.SpendingKey.Public(),
+		ViewingKey:  k.ViewingKey.Public(),
+	}
+}
+
+// StealthViewingKey returns the StealthViewingKey for the keys, which can be
+// shared with a scanning service.
+func (k *StealthKeys) StealthViewingKey() *StealthViewingKey {
+	return &StealthViewingKey{ViewingKey: k.ViewingKey, SpendingKey: k.SpendingKey.Public()}
+}
+
+// PrivateKey returns the one-time private scalar p = k_s + hs of the stealth
+// address, such that p * B8 is its one-time public key.  Returns
+// ErrStealthAddressMismatch if the address does not belong to the keys.
+func (k *StealthKeys) PrivateKey(addr *StealthAddress) (*PrivKeyScalar, error) {
+	hs, err := k.StealthViewingKey().hashedSecret(addr)
+	if err != nil {
+		return nil, err
+	}
+	p := new(big.Int).Add(k.SpendingKey.BigInt(), hs)
+	p.Mod(p, SubOrder)
+	return NewPrivKeyScalar(p), nil
+}
+
+// SignPoseidon signs a message encoded as a big.Int in Zq with the one-time
+// private scalar of the stealth address, as PrivateKey.SignPoseidon does.
+// The signature verifies with addr.PublicKey.VerifyPoseidon.  The nonce is
+// deterministic, derived from the private scalars of the keys, the one-time
+// private scalar and the message.  Returns ErrStealthAddressMismatch if the
+// address does not belong to the keys.
+func (k *StealthKeys) SignPoseidon(addr *StealthAddress, msg *big.Int) (*Signature, error) {
+	p, err := k.PrivateKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	r := k.nonce(p, msg)
+	R8 := NewPoint().MulScalar(r, B8) // R8 = r * 8 * B
+	hm, err := challengePoseidon(R8, addr.PublicKey.Point(), msg)
+	if err != nil {
+		return nil, err
+	}
+	S := signResponse(r, hm, p) // S = r + hm * 8 * p
+	return &Signature{R8: R8, S: S}, nil
+}
+
+// nonce computes the nonce r = H(tag, k_s, k_v, p, msg) of a signature made
+// with the one-time private scalar p.
+func (k *StealthKeys) nonce(p *PrivKeyScalar, msg *big.Int) *scalar.Element {
+	rInput := append([]byte{}, stealthNonceTag...)
+	for _, x := range []*big.Int{k.SpendingKey.BigInt(), k.ViewingKey.BigInt(),
+		p.BigInt(), msg} {
+		buf := utils.BigIntLEBytes(x)
+		rInput = append(rInput, buf[:]...)
+	}
+	return scalar.NewElement().SetBytesLEWide(Blake512(rInput))
+}
@@ -0,0 +1,114 @@
+package babyjub
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/poseidon"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStealthAddress(t *testing.T) {
+	spending, err := NewRandPrivKey()
+	require.NoError(t, err)
+	viewing, err := NewRandPrivKey()
+	require.NoError(t, err)
+	keys := NewStealthKeys(&spending, &viewing)
+	meta := keys.MetaAddress()
+	assert.Equal(t, spending.Public(), meta.SpendingKey)
+	assert.Equal(t, viewing.Public(), meta.ViewingKey)
+
+	addr, err := meta.NewStealthAddress(nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, meta.SpendingKey, addr.PublicKey)
+	require.NoError(t, addr.PublicKey.Validate())
+
+	ok, err := keys.StealthViewingKey().Check(addr)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	p, err := keys.PrivateKey(addr)
+	require.NoError(t, err)
+	assert.Equal(t, addr.PublicKey, p.Public())
+
+	// Two addresses for the same meta address are different
+	addr2, err := meta.NewStealthAddress(nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, addr.PublicKey, addr2.PublicKey)
+	p2, err := keys.PrivateKey(addr2)
+	require.NoError(t, err)
+	assert.Equal(t, addr2.PublicKey, p2.Public())
+
+	// Another recipient does not match the address
+	other := NewStealthKeys(&viewing, &spending)
+	ok, err = other.StealthViewingKey().Check(addr)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, err = other.PrivateKey(addr)
+	assert.ErrorIs(t, err, ErrStealthAddressMismatch)
+
+	// Wrong view tag or one-time public key
+	wrongTag := *addr
+	wrongTag.ViewTag++
+	ok, err = keys.StealthViewingKey().Check(&wrongTag)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	wrongPk := *addr
+	wrongPk.PublicKey = addr2.PublicKey
+	ok, err = keys.StealthViewingKey().Check(&wrongPk)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Invalid ephemeral key
+	wrongEph := *addr
+	wrongEph.EphemeralKey = (*PublicKey)(NewPoint())
+	_, err = keys.StealthViewingKey().Check(&wrongEph)
+	assert.ErrorIs(t, err, ErrIdentity)
+}
+
+func TestStealthViewTag(t *testing.T) {
+	// The view tag of S = 12345 * B8, computed independently from the
+	// coordinates of S as the first byte of their Keccak256 hash.
+	S := NewPoint().Mul(big.NewInt(12345), B8)
+	assert.Equal(t,
+		"19099552327547260981542886231210125691902505931204088720746463491300185142606",
+		S.X.String())
+	assert.Equal(t,
+		"13276557205153692030187527501273228448057533426731746626187331221465573305487",
+		S.Y.String())
+
+	hs, viewTag, err := stealthSharedSecret(S)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x60), viewTag)
+	h, err := poseidon.Hash([]*big.Int{S.X, S.Y})
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mod(h, SubOrder), hs)
+}
+
+func TestStealthSignPoseidon(t *testing.T) {
+	spending, err := NewRandPrivKey()
+	require.NoError(t, err)
+	viewing, err := NewRandPrivKey()
+	require.NoError(t, err)
+	keys := NewStealthKeys(&spending, &viewing)
+	addr, err := keys.MetaAddress().NewStealthAddress(nil)
+	require.NoError(t, err)
+
+	msg := big.NewInt(42)
+	sig, err := keys.SignPoseidon(addr, msg)
+	require.NoError(t, err)
+	assert.NoError(t, addr.PublicKey.VerifyPoseidon(msg, sig))
+	assert.Error(t, addr.PublicKey.VerifyPoseidon(big.NewInt(43), sig))
+	assert.Error(t, keys.MetaAddress().SpendingKey.VerifyPoseidon(msg, sig))
+
+	// Deterministic nonce
+	sig2, err := keys.SignPoseidon(addr, msg)
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+
+	other := NewStealthKeys(&viewing, &spending)
+	_, err = other.SignPoseidon(addr, msg)
+	assert.ErrorIs(t, err, ErrStealthAddressMismatch)
+}
@@ -0,0 +1,109 @@
+package babyjub
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// WeierstrassA is the a coefficient of the babyjub curve in short Weierstrass
+// form y^2 = x^3 + a*x + b, with a = (3 - A^2) / (3 * B^2), where A and B are
+// the Montgomery coefficients.
+var WeierstrassA *big.Int
+
+// WeierstrassB is the b coefficient of the babyjub curve in short Weierstrass
+// form y^2 = x^3 + a*x + b, with b = (2 * A^3 - 9 * A) / (27 * B^3), where A
+// and B are the Montgomery coefficients.
+var WeierstrassB *big.Int
+
+// montAThird is MontA / 3 mod Q, the shift between the Montgomery u and the
+// Weierstrass x coordinates.
+var montAThird *big.Int
+
+func init() {
+	WeierstrassA, _ = utils.NewIntFromString(
+		"7296080957279758407415468581752425029516121466805344781232734728849116493472")
+	WeierstrassB, _ = utils.NewIntFromString(
+		"16213513238399463127589930181672055621146936592900766180517188641980520820846")
+	montAThird, _ = utils.NewIntFromString(
+		"7296080957279758407415468581752425029516121466805344781232734728858602888105")
+}
+
+// PointWeierstrass represents a point of the babyjub curve in short
+// Weierstrass form.  The point at infinity, which corresponds to the identity
+// (0, 1) in twisted Edwards form, is represented with Infinity set to true.
+type PointWeierstrass struct {
+	X        *big.Int
+	Y        *big.Int
+	Infinity bool
+}
+
+// Weierstrass returns the PointMontgomery p in short Weierstrass form, using
+// the map x = (u + A / 3) / B, y = v / B.  Since B = 1, this is
+// x = u + A / 3, y = v.
+func (p *PointMontgomery) Weierstrass() *PointWeierstrass {
+	if p.Infinity {
+		return &PointWeierstrass{X: big.NewInt(0), Y: big.NewInt(0), Infinity: true}
+	}
+	x := new(big.Int).Add(p.U, montAThird)
+	x.Mod(x, constants.Q)
+	return &PointWeierstrass{X: x, Y: new(big.Int).Set(p.V)}
+}
+
+// Montgomery returns the PointWeierstrass p in Montgomery form, using the map
+// u = B * x - A / 3, v = B * y.  Returns an error wrapping ErrNotOnCurve if p
+// is not in the curve.
+func (p *PointWeierstrass) Montgomery() (*PointMontgomery, error) {
+	if !p.InCurve() {
+		return nil, fmt.Errorf("%w: weierstrass point", ErrNotOnCurve)
+	}
+	if p.Infinity {
+		return &PointMontgomery{U: big.NewInt(0), V: big.NewInt(0), Infinity: true}, nil
+	}
+	u := new(big.Int).Sub(p.X, montAThird)
+	u.Mod(u, constants.Q)
+	return &PointMontgomery{U: u, V: new(big.Int).Set(p.Y)}, nil
+}
+
+// InCurve returns true when the PointWeierstrass p is in the babyjub curve in
+// short Weierstrass form.
+func (p *PointWeierstrass) InCurve() bool {
+	if p.Infinity {
+		return true
+	}
+	if !checkCoords(p.X, p.Y) {
+		return false
+	}
+	left := new(big.Int).Mul(p.Y, p.Y)
+	left.Mod(left, constants.Q)
+	// x^3 + a*x + b = x * (x^2 + a) + b
+	right := new(big.Int).Mul(p.X, p.X)
+	right.Add(right, WeierstrassA)
+	right.Mul(right, p.X)
+	right.Add(right, WeierstrassB)
+	right.Mod(right, constants.Q)
+	return left.Cmp(right) == 0
+}
+
+// Weierstrass returns the Point p in short Weierstrass form.  Returns an
+// error wrapping ErrNotOnCurve if p is not in the curve.
+func (p *Point) Weierstrass() (*PointWeierstrass, error) {
+	pm, err := p.Montgomery()
+	if err != nil {
+		return nil, err
+	}
+	return pm.Weierstrass(), nil
+}
+
+// Edwards returns the Point in twisted Edwards form corresponding to the
+// PointWeierstrass p.  Returns an error wrapping ErrNotOnCurve if p is not in
+// the curve.
+func (p *PointWeierstrass) Edwards() (*Point, error) {
+	pm, err := p.Montgomery()
+	if err != nil {
+		return nil, err
+	}
+	return pm.Edwards()
+}
@@ -0,0 +1,56 @@
+package babyjub
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeierstrassCoefficients(t *testing.T) {
+	// With B = 1: a = (3 - A^2) / 3, b = (2 * A^3 - 9 * A) / 27
+	inv3 := new(big.Int).ModInverse(big.NewInt(3), constants.Q)
+	inv27 := new(big.Int).ModInverse(big.NewInt(27), constants.Q)
+	a := new(big.Int).Mul(MontA, MontA)
+	a.Sub(big.NewInt(3), a)
+	a.Mul(a, inv3)
+	a.Mod(a, constants.Q)
+	assert.Equal(t, WeierstrassA, a)
+	b := new(big.Int).Exp(MontA, big.NewInt(3), nil)
+	b.Lsh(b, 1)
+	b.Sub(b, new(big.Int).Mul(big.NewInt(9), MontA))
+	b.Mul(b, inv27)
+	b.Mod(b, constants.Q)
+	assert.Equal(t, WeierstrassB, b)
+}
+
+func TestWeierstrassEdwards(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		p := NewPoint().Mul(big.NewInt(int64(i*i+7)), B8)
+		pw, err := p.Weierstrass()
+		require.NoError(t, err)
+		assert.True(t, pw.InCurve())
+		pm, err := pw.Montgomery()
+		require.NoError(t, err)
+		expected, err := p.Montgomery()
+		require.NoError(t, err)
+		assert.Equal(t, expected, pm)
+		p2, err := pw.Edwards()
+		require.NoError(t, err)
+		assert.Equal(t, p, p2)
+	}
+
+	pw, err := NewPoint().Weierstrass()
+	require.NoError(t, err)
+	assert.True(t, pw.Infinity)
+	p, err := pw.Edwards()
+	require.NoError(t, err)
+	assert.Equal(t, NewPoint(), p)
+
+	_, err = (&PointWeierstrass{X: big.NewInt(1), Y: big.NewInt(1)}).Edwards()
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+	_, err = (&Point{X: big.NewInt(5), Y: big.NewInt(1)}).Weierstrass()
+	assert.ErrorIs(t, err, ErrNotOnCurve)
+}
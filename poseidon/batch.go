@@ -0,0 +1,114 @@
+package poseidon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// batchChunkSize is the number of hashes a worker computes each time it
+// takes work from a batch, and between checks of the context.
+const batchChunkSize = 64
+
+// HashBatch computes the Poseidon hash of each of the input vectors in
+// parallel, with the given number of worker goroutines, or
+// runtime.GOMAXPROCS(0) of them if workers <= 0.  The i-th output is the
+// hash of inputs[i], as computed by Hash.  All the inputs are checked before
+// hashing any of them, and the error of the first invalid one is returned.
+// If ctx is done before all the hashes are computed, ctx.Err() is returned.
+func HashBatch(ctx context.Context, inputs [][]*big.Int, workers int) ([]*big.Int, error) {
+	for i := range inputs {
+		if err := checkLengths(len(inputs[i]), 1); err != nil {
+			return nil, fmt.Errorf("inputs %d: %w", i, err)
+		}
+		if !utils.CheckBigIntArrayInField(inputs[i]) {
+			return nil, fmt.Errorf("inputs %d: inputs values not inside Finite Field", i)
+		}
+	}
+	out := make([]*big.Int, len(inputs))
+	err := runBatch(ctx, len(inputs), workers, func(i int) {
+		var st [maxWidth]ff.Element
+		state := st[:len(inputs[i])+1]
+		for j := range inputs[i] {
+			state[j+1].SetBigInt(inputs[i][j])
+		}
+		permute(state)
+		out[i] = state[0].ToBigIntRegular(new(big.Int))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HashElementsBatch computes the Poseidon hash of each of the input vectors
+// in parallel, as HashBatch does, for field elements.  The i-th output is the
+// hash of inputs[i], as computed by HashElements.
+func HashElementsBatch(ctx context.Context, inputs [][]ff.Element,
+	workers int) ([]ff.Element, error) {
+	for i := range inputs {
+		if err := checkLengths(len(inputs[i]), 1); err != nil {
+			return nil, fmt.Errorf("inputs %d: %w", i, err)
+		}
+	}
+	out := make([]ff.Element, len(inputs))
+	var zero ff.Element
+	err := runBatch(ctx, len(inputs), workers, func(i int) {
+		var st [maxWidth]ff.Element
+		out[i] = permuteElements(&st, inputs[i], &zero)[0]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// runBatch calls hash(i) for i from 0 to n-1 with the given number of
+// workers, splitting the work in chunks of batchChunkSize.  It returns
+// ctx.Err() if ctx is done before all the calls are made.
+func runBatch(ctx context.Context, n, workers int, hash func(i int)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	nChunks := (n + batchChunkSize - 1) / batchChunkSize
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > nChunks {
+		workers = nChunks
+	}
+
+	var next, done atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				chunk := int(next.Add(1)) - 1
+				if chunk >= nChunks {
+					return
+				}
+				end := (chunk + 1) * batchChunkSize
+				if end > n {
+					end = n
+				}
+				for i := chunk * batchChunkSize; i < end; i++ {
+					hash(i)
+				}
+				done.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if int(done.Load()) < nChunks {
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package poseidon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchInputs returns n input vectors of different lengths.
+func batchInputs(n int) [][]ff.Element {
+	inputs := make([][]ff.Element, n)
+	for i := range inputs {
+		inputs[i] = elems(uint64(i), uint64(i+1+i%len(NROUNDSP)))
+	}
+	return inputs
+}
+
+func TestHashBatch(t *testing.T) {
+	inputs := batchInputs(300)
+	inputsBI := make([][]*big.Int, len(inputs))
+	expected := make([]ff.Element, len(inputs))
+	for i := range inputs {
+		inputsBI[i] = toBigInts(inputs[i])
+		var err error
+		expected[i], err = HashElements(inputs[i])
+		require.NoError(t, err)
+	}
+
+	for _, workers := range []int{0, 1, 3, 100} {
+		out, err := HashElementsBatch(context.Background(), inputs, workers)
+		require.NoError(t, err)
+		assert.Equal(t, expected, out, "%d workers", workers)
+
+		outBI, err := HashBatch(context.Background(), inputsBI, workers)
+		require.NoError(t, err)
+		assert.Equal(t, toBigInts(expected), outBI, "%d workers", workers)
+	}
+
+	out, err := HashElementsBatch(context.Background(), nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+func TestHashBatchInvalidInputs(t *testing.T) {
+	inputs := batchInputs(10)
+	inputs[7] = nil
+	_, err := HashElementsBatch(context.Background(), inputs, 0)
+	assert.ErrorContains(t, err, "inputs 7:")
+
+	inputsBI := [][]*big.Int{{big.NewInt(1)}, {big.NewInt(2), ff.Modulus()}}
+	_, err = HashBatch(context.Background(), inputsBI, 0)
+	assert.ErrorContains(t, err, "inputs 1:")
+}
+
+func TestHashBatchCancel(t *testing.T) {
+	inputs := batchInputs(1000)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := HashElementsBatch(ctx, inputs, 0)
+	assert.Equal(t, context.Canceled, err)
+	_, err = HashBatch(ctx, [][]*big.Int{{big.NewInt(1)}}, 0)
+	assert.Equal(t, context.Canceled, err)
+
+	// Cancel while hashing
+	ctx, cancel = context.WithCancel(context.Background())
+	n := 0
+	err = runBatch(ctx, 10*batchChunkSize, 1, func(i int) {
+		n++
+		if i == batchChunkSize+1 {
+			cancel()
+		}
+	})
+	assert.Equal(t, context.Canceled, err)
+	// The chunk being hashed is finished, and no more are started.
+	assert.Equal(t, 2*batchChunkSize, n)
+}
+
+func BenchmarkHashElementsBatch(b *testing.B) {
+	inputs := make([][]ff.Element, 4096)
+	for i := range inputs {
+		inputs[i] = elems(uint64(i), uint64(i+2))
+	}
+	for _, workers := range []int{1, 2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = HashElementsBatch(context.Background(), inputs, workers)
+			}
+		})
+	}
+}
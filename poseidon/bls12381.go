@@ -0,0 +1,148 @@
+package poseidon
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/ffbls12381"
+)
+
+// BLS12381Params returns the parameters of the Poseidon instance of width t
+// over the BLS12-381 scalar field: x^5 S-box, 128 bits of security and the
+// numbers of rounds of the reference instances poseidonperm_x5_255_3 and
+// poseidonperm_x5_255_5, 8 full rounds and 57 or 60 partial rounds.  Only
+// these widths, 3 and 5, are supported.
+func BLS12381Params(t int) (Params, error) {
+	if t != 3 && t != 5 {
+		return Params{}, fmt.Errorf("invalid width %d, must be 3 or 5", t)
+	}
+	params, err := BuiltinParams(t)
+	if err != nil {
+		return Params{}, err
+	}
+	params.Modulus = ffbls12381.Modulus()
+	return params, nil
+}
+
+// BLS12381 is a Poseidon instance over the BLS12-381 scalar field, with the
+// constants of BLS12381Params.
+type BLS12381 struct {
+	t   int
+	rf  int
+	rp  int
+	rc  [][]ffbls12381.Element
+	mds [][]ffbls12381.Element
+}
+
+// NewBLS12381 generates the constants of the Poseidon instance of width t, 3
+// or 5, over the BLS12-381 scalar field.
+func NewBLS12381(t int) (*BLS12381, error) {
+	params, err := BLS12381Params(t)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := NewInstance(params)
+	if err != nil {
+		return nil, err
+	}
+	return &BLS12381{
+		t:   t,
+		rf:  params.RoundsF,
+		rp:  params.RoundsP,
+		rc:  blsElements(inst.rc),
+		mds: blsElements(inst.mds),
+	}, nil
+}
+
+func blsElements(m [][]*big.Int) [][]ffbls12381.Element {
+	r := make([][]ffbls12381.Element, len(m))
+	for i := range m {
+		r[i] = make([]ffbls12381.Element, len(m[i]))
+		for j := range m[i] {
+			r[i][j].SetBigInt(m[i][j])
+		}
+	}
+	return r
+}
+
+// Width returns the width t of the permutation.
+func (h *BLS12381) Width() int {
+	return h.t
+}
+
+// Permute applies the permutation to the state, of t elements, in place.
+func (h *BLS12381) Permute(state []ffbls12381.Element) error {
+	if len(state) != h.t {
+		return fmt.Errorf("invalid state length %d, must be %d", len(state), h.t)
+	}
+	h.permute(state)
+	return nil
+}
+
+func (h *BLS12381) permute(state []ffbls12381.Element) {
+	var st [maxWidth]ffbls12381.Element
+	newState := st[:h.t]
+	halfF := h.rf / 2 //nolint:gomnd
+	for r, rc := range h.rc {
+		for i := range state {
+			state[i].Add(&state[i], &rc[i])
+		}
+		if r < halfF || r >= halfF+h.rp {
+			for i := range state {
+				exp5BLS12381(&state[i])
+			}
+		} else {
+			exp5BLS12381(&state[0])
+		}
+		var mul ffbls12381.Element
+		for i := range newState {
+			newState[i].SetZero()
+			for j := range state {
+				mul.Mul(&h.mds[i][j], &state[j])
+				newState[i].Add(&newState[i], &mul)
+			}
+		}
+		copy(state, newState)
+	}
+}
+
+// exp5BLS12381 performs x^5 as x * (x^2)^2.
+func exp5BLS12381(a *ffbls12381.Element) {
+	var a4 ffbls12381.Element
+	a4.Square(a)
+	a4.Square(&a4)
+	a.Mul(a, &a4)
+}
+
+// HashElements computes the hash of t-1 inputs: the first element of the
+// permutation of the state [0, inputs...].
+func (h *BLS12381) HashElements(inputs []ffbls12381.Element) (ffbls12381.Element, error) {
+	if len(inputs) != h.t-1 {
+		return ffbls12381.Element{}, fmt.Errorf("invalid inputs length %d, must be %d",
+			len(inputs), h.t-1)
+	}
+	var st [maxWidth]ffbls12381.Element
+	state := st[:h.t]
+	copy(state[1:], inputs)
+	h.permute(state)
+	return state[0], nil
+}
+
+// Hash computes the hash of t-1 inputs, which must be inside the BLS12-381
+// scalar field, as HashElements does.
+func (h *BLS12381) Hash(inputs []*big.Int) (*big.Int, error) {
+	q := ffbls12381.Modulus()
+	elems := make([]ffbls12381.Element, len(inputs))
+	for i := range inputs {
+		if inputs[i] == nil || inputs[i].Sign() < 0 || inputs[i].Cmp(q) >= 0 {
+			return nil, errors.New("inputs values not inside Finite Field")
+		}
+		elems[i].SetBigInt(inputs[i])
+	}
+	r, err := h.HashElements(elems)
+	if err != nil {
+		return nil, err
+	}
+	return r.ToBigIntRegular(new(big.Int)), nil
+}
@@ -0,0 +1,95 @@
+package poseidon
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ffbls12381"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors of the reference implementation
+// (https://extgit.iaik.tugraz.at/krypto/hadeshash), the permutation of
+// [0, 1, ..., t-1] for poseidonperm_x5_255_3 and poseidonperm_x5_255_5.
+var bls12381Vectors = map[int][]string{
+	3: {
+		"28ce19420fc246a05553ad1e8c98f5c9d67166be2c18e9e4cb4b4e317dd2a78a",
+		"51f3e312c95343a896cfd8945ea82ba956c1118ce9b9859b6ea56637b4b1ddc4",
+		"3b2b69139b235626a0bfb56c9527ae66a7bf486ad8c11c14d1da0c69bbe0f79a",
+	},
+	5: {
+		"2a918b9c9f9bd7bb509331c81e297b5707f6fc7393dcee1b13901a0b22202e18",
+		"65ebf8671739eeb11fb217f2d5c5bf4a0c3f210e3f3cd3b08b5db75675d797f7",
+		"2cc176fc26bc70737a696a9dfd1b636ce360ee76926d182390cdb7459cf585ce",
+		"4dc4e29d283afd2a491fe6aef122b9a968e74eff05341f3cc23fda1781dcb566",
+		"03ff622da276830b9451b88b85e6184fd6ae15c8ab3ee25a5667be8592cce3b1",
+	},
+}
+
+func TestBLS12381Permute(t *testing.T) {
+	for width, want := range bls12381Vectors {
+		h, err := NewBLS12381(width)
+		require.NoError(t, err)
+		assert.Equal(t, width, h.Width())
+
+		state := make([]ffbls12381.Element, width)
+		for i := range state {
+			state[i].SetUint64(uint64(i))
+		}
+		require.NoError(t, h.Permute(state))
+		for i := range state {
+			assert.Equal(t, want[i],
+				fmt.Sprintf("%064x", state[i].ToBigIntRegular(new(big.Int))), "t = %d", width)
+		}
+		assert.Error(t, h.Permute(state[1:]))
+	}
+}
+
+func TestBLS12381Hash(t *testing.T) {
+	h, err := NewBLS12381(3)
+	require.NoError(t, err)
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	res, err := h.Hash(inputs)
+	require.NoError(t, err)
+
+	// Same result as the generic instance.
+	params, err := BLS12381Params(3)
+	require.NoError(t, err)
+	inst, err := NewInstance(params)
+	require.NoError(t, err)
+	want, err := inst.Hash(inputs)
+	require.NoError(t, err)
+	assert.Equal(t, want, res)
+
+	var elems [2]ffbls12381.Element
+	elems[0].SetUint64(1)
+	elems[1].SetUint64(2)
+	resElem, err := h.HashElements(elems[:])
+	require.NoError(t, err)
+	assert.Equal(t, res, resElem.ToBigIntRegular(new(big.Int)))
+
+	_, err = h.Hash(inputs[:1])
+	assert.Error(t, err)
+	_, err = h.Hash([]*big.Int{big.NewInt(1), ffbls12381.Modulus()})
+	assert.Error(t, err)
+	for _, width := range []int{2, 4, 6, 17, 18} {
+		_, err = NewBLS12381(width)
+		assert.Error(t, err)
+		_, err = BLS12381Params(width)
+		assert.Error(t, err)
+	}
+}
+
+func BenchmarkBLS12381Hash(b *testing.B) {
+	h, err := NewBLS12381(3)
+	require.NoError(b, err)
+	var inputs [2]ffbls12381.Element
+	inputs[0].SetUint64(1)
+	inputs[1].SetUint64(2)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = h.HashElements(inputs[:])
+	}
+}
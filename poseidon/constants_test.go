@@ -0,0 +1,125 @@
+package poseidon
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstantsData(t *testing.T) {
+	require.Len(t, constantsData, len(NROUNDSP))
+	q := ff.Modulus()
+	for i := range constantsData {
+		width := i + 2
+		d := &constantsData[i]
+		assert.Len(t, d.c, NROUNDSF*width+NROUNDSP[i], "t = %d", width)
+		assert.Len(t, d.s, (2*width-1)*NROUNDSP[i], "t = %d", width)
+		assert.Len(t, d.m, width*width, "t = %d", width)
+		assert.Len(t, d.p, width*width, "t = %d", width)
+		for _, es := range [][]ff.Element{d.c, d.s, d.m, d.p} {
+			for j := range es {
+				// The Montgomery form of an element is reduced too.
+				v := new(big.Int)
+				for k := len(es[j]) - 1; k >= 0; k-- {
+					v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(es[j][k]))
+				}
+				assert.Less(t, v.Cmp(q), 0)
+			}
+		}
+	}
+}
+
+func TestConstantsSource(t *testing.T) {
+	// constants.go is generated from the committed source.
+	data, err := os.ReadFile("testdata/poseidon_constants_opt.json")
+	require.NoError(t, err)
+	var src struct {
+		C [][]string
+		S [][]string
+		M [][][]string
+		P [][][]string
+	}
+	require.NoError(t, json.Unmarshal(data, &src))
+	require.Len(t, src.C, len(constantsData))
+	for i := range constantsData {
+		d := &constantsData[i]
+		var m, p []string
+		for j := range src.M[i] {
+			m = append(m, src.M[i][j]...)
+			p = append(p, src.P[i][j]...)
+		}
+		for _, c := range []struct {
+			es   []ff.Element
+			want []string
+		}{{d.c, src.C[i]}, {d.s, src.S[i]}, {d.m, m}, {d.p, p}} {
+			require.Len(t, c.es, len(c.want), "t = %d", i+2)
+			for j := range c.es {
+				assert.Equal(t, c.want[j],
+					"0x"+c.es[j].ToBigIntRegular(new(big.Int)).Text(16), "t = %d", i+2)
+			}
+		}
+	}
+}
+
+func TestGetConstantsConcurrent(t *testing.T) {
+	// All the goroutines must see the same loaded constants.
+	var wg sync.WaitGroup
+	res := make([]*constants, 32)
+	for i := range res {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = getConstants(2 + i%len(NROUNDSP))
+		}(i)
+	}
+	wg.Wait()
+	for i := range res {
+		assert.Same(t, getConstants(2+i%len(NROUNDSP)), res[i])
+	}
+}
+
+// BenchmarkFirstUse measures the work done the first time a width is used:
+// loading its constants, alone and as part of the first hash.  The constants
+// are compiled in as field elements (constantsData), so importing the package
+// does not parse them and there is no import-time cost to measure.
+func BenchmarkFirstUse(b *testing.B) {
+	b.Run("t=3", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = loadConstants(3)
+		}
+	})
+	b.Run("all", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for t := 2; t <= len(NROUNDSP)+1; t++ {
+				_ = loadConstants(t)
+			}
+		}
+	})
+	for _, t := range []int{3, 17} {
+		b.Run(fmt.Sprintf("first hash t=%d", t), func(b *testing.B) {
+			inputs := bigInts(1, int64(t))
+			// Restore the constants loaded before the benchmark, so that
+			// the resets below do not leak into other tests and benchmarks.
+			saved := getConstants(t)
+			b.Cleanup(func() {
+				constantsOnce[t-2] = sync.Once{}
+				constantsOnce[t-2].Do(func() { constantsT[t-2] = saved })
+			})
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// Reset the cache so that every hash loads the constants.
+				constantsOnce[t-2] = sync.Once{}
+				_, _ = Hash(inputs)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package poseidon
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"golang.org/x/crypto/sha3"
+)
+
+// The Sponge implements the SAFE API (Sponge API for Field Elements,
+// https://eprint.iacr.org/2023/522) on top of the Poseidon permutation.  A
+// sponge is started with an IO pattern, the list of Absorb and Squeeze calls
+// that will be made, and a domain separator.  Both are hashed into a tag that
+// initializes the capacity element, state[0]; the rate is state[1:].  Calls
+// that do not follow the IO pattern are rejected, and Finish checks that the
+// whole pattern has been used.
+
+// ErrIOPatternMismatch is returned when a Sponge call does not follow the IO
+// pattern of the sponge.
+var ErrIOPatternMismatch = errors.New("sponge call does not match the IO pattern")
+
+// ioAbsorbFlag marks the absorb calls in the encoding of an IO pattern.
+const ioAbsorbFlag = 1 << 31
+
+// IOCall is a call of an IO pattern, encoded as in SAFE: the length of the
+// call, with the most significant bit set for absorb calls.
+type IOCall uint32
+
+// AbsorbCall returns the IO pattern call that absorbs n elements.
+func AbsorbCall(n uint32) IOCall {
+	return IOCall(ioAbsorbFlag | n)
+}
+
+// SqueezeCall returns the IO pattern call that squeezes n elements.
+func SqueezeCall(n uint32) IOCall {
+	return IOCall(n)
+}
+
+func (c IOCall) isAbsorb() bool {
+	return c&ioAbsorbFlag != 0
+}
+
+func (c IOCall) len() uint32 {
+	return uint32(c &^ ioAbsorbFlag)
+}
+
+// IOPattern is the list of calls made to a Sponge.
+type IOPattern []IOCall
+
+// aggregate returns the IO pattern with the consecutive calls of the same
+// kind merged into a single call.  Returns error for empty calls.
+func (p IOPattern) aggregate() (IOPattern, error) {
+	var res IOPattern
+	for _, call := range p {
+		if call.len() == 0 {
+			return nil, errors.New("empty call in IO pattern")
+		}
+		last := len(res) - 1
+		if last >= 0 && res[last].isAbsorb() == call.isAbsorb() {
+			if uint64(res[last].len())+uint64(call.len()) >= ioAbsorbFlag {
+				return nil, errors.New("IO pattern call too long")
+			}
+			res[last] += IOCall(call.len())
+			continue
+		}
+		res = append(res, call)
+	}
+	if len(res) == 0 {
+		return nil, errors.New("empty IO pattern")
+	}
+	return res, nil
+}
+
+// Tag returns the tag of the IO pattern with the domain separator domain:
+// the first 128 bits of the SHA3-256 hash of the aggregated calls, encoded as
+// 32 bit big-endian words, followed by domain.
+func (p IOPattern) Tag(domain []byte) (ff.Element, error) {
+	agg, err := p.aggregate()
+	if err != nil {
+		return ff.Element{}, err
+	}
+	h := sha3.New256()
+	var word [4]byte
+	for _, call := range agg {
+		binary.BigEndian.PutUint32(word[:], uint32(call))
+		_, _ = h.Write(word[:])
+	}
+	_, _ = h.Write(domain)
+	var tag ff.Element
+	tag.SetBigInt(new(big.Int).SetBytes(h.Sum(nil)[:16])) //nolint:gomnd
+	return tag, nil
+}
+
+// Sponge is a duplex sponge over the Poseidon permutation following the SAFE
+// API.  A Sponge must not be used after a call returns an error.
+type Sponge struct {
+	state      []ff.Element
+	pattern    IOPattern
+	absorbPos  int
+	squeezePos int
+	aborted    bool
+}
+
+// NewSponge starts a sponge with the given width, the number of elements of
+// the Poseidon state (from 2 to 17), which will be called following the IO
+// pattern, with the domain separator domain.
+func NewSponge(width int, pattern IOPattern, domain []byte) (*Sponge, error) {
+	if width < 2 || width > len(NROUNDSP)+1 {
+		return nil, fmt.Errorf("invalid width %d, min 2, max %d", width, len(NROUNDSP)+1)
+	}
+	agg, err := pattern.aggregate()
+	if err != nil {
+		return nil, err
+	}
+	tag, err := pattern.Tag(domain)
+	if err != nil {
+		return nil, err
+	}
+	s := &Sponge{
+		state:   make([]ff.Element, width),
+		pattern: agg,
+	}
+	s.state[0] = tag
+	s.squeezePos = s.rate()
+	return s, nil
+}
+
+func (s *Sponge) rate() int {
+	return len(s.state) - 1
+}
+
+// call consumes a call of n elements from the IO pattern, aborting the sponge
+// if the call does not follow it.
+func (s *Sponge) call(absorb bool, n int) error {
+	if s.aborted || len(s.pattern) == 0 || s.pattern[0].isAbsorb() != absorb ||
+		uint64(n) > uint64(s.pattern[0].len()) {
+		s.abort()
+		return ErrIOPatternMismatch
+	}
+	s.pattern[0] -= IOCall(n)
+	if s.pattern[0].len() == 0 {
+		s.pattern = s.pattern[1:]
+	}
+	return nil
+}
+
+// abort erases the state and the IO pattern, so that any further call fails.
+func (s *Sponge) abort() {
+	for i := range s.state {
+		s.state[i].SetZero()
+	}
+	s.pattern = nil
+	s.aborted = true
+}
+
+func (s *Sponge) permute() {
+	// The width is checked in NewSponge.
+	_ = Permute(s.state)
+}
+
+// Absorb absorbs the inputs into the sponge, adding them to the rate and
+// applying the permutation each time the rate is full.
+func (s *Sponge) Absorb(inputs []ff.Element) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+	if err := s.call(true, len(inputs)); err != nil {
+		return err
+	}
+	for i := range inputs {
+		if s.absorbPos == s.rate() {
+			s.permute()
+			s.absorbPos = 0
+		}
+		s.state[1+s.absorbPos].Add(&s.state[1+s.absorbPos], &inputs[i])
+		s.absorbPos++
+	}
+	// The next squeeze must apply the permutation.
+	s.squeezePos = s.rate()
+	return nil
+}
+
+// Squeeze returns n elements read from the rate of the sponge, applying the
+// permutation each time the whole rate has been read.
+func (s *Sponge) Squeeze(n int) ([]ff.Element, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	if err := s.call(false, n); err != nil {
+		return nil, err
+	}
+	out := make([]ff.Element, n)
+	for i := range out {
+		if s.squeezePos == s.rate() {
+			s.permute()
+			s.squeezePos = 0
+			s.absorbPos = 0
+		}
+		out[i] = s.state[1+s.squeezePos]
+		s.squeezePos++
+	}
+	return out, nil
+}
+
+// Ratchet applies the permutation and erases the rate, so that the previous
+// states of the sponge cannot be recovered from the current one.  Ratchet
+// calls are not part of the IO pattern.
+func (s *Sponge) Ratchet() {
+	s.permute()
+	for i := 1; i < len(s.state); i++ {
+		s.state[i].SetZero()
+	}
+	s.absorbPos = 0
+	s.squeezePos = s.rate()
+}
+
+// Finish checks that the whole IO pattern has been used, and erases the
+// state of the sponge.
+func (s *Sponge) Finish() error {
+	used := !s.aborted && len(s.pattern) == 0
+	s.abort()
+	if !used {
+		return ErrIOPatternMismatch
+	}
+	return nil
+}
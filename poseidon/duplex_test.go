@@ -0,0 +1,152 @@
+package poseidon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func elems(from, to uint64) []ff.Element {
+	r := make([]ff.Element, 0, to-from)
+	for i := from; i < to; i++ {
+		r = append(r, ff.NewElementFromUint64(i))
+	}
+	return r
+}
+
+func toBigInts(es []ff.Element) []*big.Int {
+	r := make([]*big.Int, len(es))
+	for i := range es {
+		r[i] = es[i].ToBigIntRegular(new(big.Int))
+	}
+	return r
+}
+
+func TestPermute(t *testing.T) {
+	for width := 2; width <= 17; width++ {
+		state := append([]ff.Element{{}}, elems(1, uint64(width))...)
+		require.NoError(t, Permute(state))
+		expected, err := HashEx(bigInts(1, int64(width)), width)
+		require.NoError(t, err)
+		assert.Equal(t, expected, toBigInts(state), "width %d", width)
+	}
+	assert.Error(t, Permute(make([]ff.Element, 1)))
+	assert.Error(t, Permute(make([]ff.Element, 18)))
+}
+
+func TestIOPatternTag(t *testing.T) {
+	p := IOPattern{AbsorbCall(2), SqueezeCall(1)}
+	tag, err := p.Tag([]byte("domain"))
+	require.NoError(t, err)
+	assert.Less(t, tag.ToBigIntRegular(new(big.Int)).BitLen(), 129)
+
+	// Consecutive calls of the same kind are aggregated
+	tag2, err := IOPattern{AbsorbCall(1), AbsorbCall(1), SqueezeCall(1)}.Tag([]byte("domain"))
+	require.NoError(t, err)
+	assert.Equal(t, tag, tag2)
+
+	tag3, err := p.Tag([]byte("other domain"))
+	require.NoError(t, err)
+	assert.NotEqual(t, tag, tag3)
+	tag4, err := IOPattern{AbsorbCall(2), SqueezeCall(2)}.Tag([]byte("domain"))
+	require.NoError(t, err)
+	assert.NotEqual(t, tag, tag4)
+
+	_, err = IOPattern{}.Tag(nil)
+	assert.Error(t, err)
+	_, err = IOPattern{AbsorbCall(0)}.Tag(nil)
+	assert.Error(t, err)
+}
+
+func TestSponge(t *testing.T) {
+	domain := []byte("test")
+	pattern := IOPattern{AbsorbCall(2), SqueezeCall(1)}
+	tag, err := pattern.Tag(domain)
+	require.NoError(t, err)
+	tagBI := tag.ToBigIntRegular(new(big.Int))
+
+	// A single permutation matches HashWithStateEx with the tag as the
+	// initial state.
+	s, err := NewSponge(3, pattern, domain)
+	require.NoError(t, err)
+	require.NoError(t, s.Absorb(elems(1, 3)))
+	out, err := s.Squeeze(1)
+	require.NoError(t, err)
+	require.NoError(t, s.Finish())
+	expected, err := HashWithStateEx(bigInts(1, 3), tagBI, 2)
+	require.NoError(t, err)
+	assert.Equal(t, expected[1:], toBigInts(out))
+
+	// Absorbing more than the rate, and squeezing more than the rate.
+	pattern = IOPattern{AbsorbCall(3), AbsorbCall(1), SqueezeCall(2), SqueezeCall(1)}
+	tag, err = pattern.Tag(domain)
+	require.NoError(t, err)
+	s, err = NewSponge(3, pattern, domain)
+	require.NoError(t, err)
+	require.NoError(t, s.Absorb(elems(1, 4)))
+	require.NoError(t, s.Absorb(elems(4, 5)))
+	out, err = s.Squeeze(2)
+	require.NoError(t, err)
+	out2, err := s.Squeeze(1)
+	require.NoError(t, err)
+	require.NoError(t, s.Finish())
+
+	st, err := HashWithStateEx(bigInts(1, 3), tag.ToBigIntRegular(new(big.Int)), 3)
+	require.NoError(t, err)
+	st[1].Add(st[1], big.NewInt(3)).Mod(st[1], ff.Modulus())
+	st[2].Add(st[2], big.NewInt(4)).Mod(st[2], ff.Modulus())
+	st, err = HashWithStateEx(st[1:], st[0], 3)
+	require.NoError(t, err)
+	assert.Equal(t, st[1:], toBigInts(out))
+	st, err = HashWithStateEx(st[1:], st[0], 3)
+	require.NoError(t, err)
+	assert.Equal(t, st[1:2], toBigInts(out2))
+}
+
+func TestSpongeIOPatternMismatch(t *testing.T) {
+	pattern := IOPattern{AbsorbCall(2), SqueezeCall(1)}
+	_, err := NewSponge(1, pattern, nil)
+	assert.Error(t, err)
+	_, err = NewSponge(18, pattern, nil)
+	assert.Error(t, err)
+
+	// Squeeze before absorb
+	s, err := NewSponge(3, pattern, nil)
+	require.NoError(t, err)
+	_, err = s.Squeeze(1)
+	assert.Equal(t, ErrIOPatternMismatch, err)
+	// The sponge is unusable after an error
+	assert.Equal(t, ErrIOPatternMismatch, s.Absorb(elems(1, 3)))
+	assert.Equal(t, ErrIOPatternMismatch, s.Finish())
+
+	// Absorbing too much
+	s, err = NewSponge(3, pattern, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ErrIOPatternMismatch, s.Absorb(elems(1, 4)))
+
+	// Pattern not finished
+	s, err = NewSponge(3, pattern, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Absorb(elems(1, 3)))
+	assert.Equal(t, ErrIOPatternMismatch, s.Finish())
+}
+
+func TestSpongeRatchet(t *testing.T) {
+	pattern := IOPattern{AbsorbCall(2), SqueezeCall(1)}
+	s1, err := NewSponge(3, pattern, nil)
+	require.NoError(t, err)
+	s2, err := NewSponge(3, pattern, nil)
+	require.NoError(t, err)
+	s2.Ratchet()
+	require.NoError(t, s1.Absorb(elems(1, 3)))
+	require.NoError(t, s2.Absorb(elems(1, 3)))
+	out1, err := s1.Squeeze(1)
+	require.NoError(t, err)
+	out2, err := s2.Squeeze(1)
+	require.NoError(t, err)
+	assert.NotEqual(t, out1, out2)
+	require.NoError(t, s2.Finish())
+}
@@ -0,0 +1,111 @@
+//go:build ignore
+
+// gen_constants generates constants.go from the JSON encoding of the
+// optimized Poseidon constants of circomlibjs (poseidon_constants_opt.js),
+// an object with the hexadecimal C, S, M and P constants of each width,
+// committed in testdata/poseidon_constants_opt.json.  The constants are
+// written as ff.Element literals in Montgomery form, so that nothing has to
+// be parsed at run time.  TestConstantsSource checks that constants.go is
+// up to date with the source, and CheckBuiltinConstants that the source is
+// the one of the reference Poseidon instances.
+//
+// Usage, from the poseidon directory:
+//
+//	go generate
+//
+// or
+//
+//	go run gen_constants.go testdata/poseidon_constants_opt.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+)
+
+type constantsJSON struct {
+	C [][]string
+	S [][]string
+	M [][][]string
+	P [][][]string
+}
+
+func main() {
+	if len(os.Args) != 2 { //nolint:gomnd
+		log.Fatal("usage: go run gen_constants.go testdata/poseidon_constants_opt.json")
+	}
+	data, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	var cs constantsJSON
+	if err := json.Unmarshal(data, &cs); err != nil {
+		log.Fatal(err)
+	}
+	if len(cs.S) != len(cs.C) || len(cs.M) != len(cs.C) || len(cs.P) != len(cs.C) {
+		log.Fatal("different number of widths in C, S, M and P")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen_constants.go; DO NOT EDIT.\n\n")
+	b.WriteString("package poseidon\n\n")
+	b.WriteString("import \"github.com/iden3/go-iden3-crypto/v2/ff\"\n\n")
+	b.WriteString("// constantsData are the constants of each width, from t = 2, in Montgomery\n")
+	b.WriteString("// form.  The matrices M and P are stored by rows.\n")
+	b.WriteString("//\n//nolint:lll,dupl // long lines, duplicated parts\n")
+	b.WriteString("var constantsData = [...]constantsLit{\n")
+	for i := range cs.C {
+		t := i + 2 //nolint:gomnd
+		if len(cs.M[i]) != t || len(cs.P[i]) != t {
+			log.Fatalf("invalid matrix size for t = %d", t)
+		}
+		fmt.Fprintf(&b, "// t = %d\n{\n", t)
+		writeElements(&b, "c", cs.C[i])
+		writeElements(&b, "s", cs.S[i])
+		writeElements(&b, "m", flatten(cs.M[i], t))
+		writeElements(&b, "p", flatten(cs.P[i], t))
+		b.WriteString("},\n")
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("constants.go", src, 0o644); err != nil { //nolint:gosec,gomnd
+		log.Fatal(err)
+	}
+}
+
+func flatten(m [][]string, t int) []string {
+	var r []string
+	for _, row := range m {
+		if len(row) != t {
+			log.Fatalf("invalid matrix size for t = %d", t)
+		}
+		r = append(r, row...)
+	}
+	return r
+}
+
+func writeElements(b *bytes.Buffer, name string, values []string) {
+	fmt.Fprintf(b, "%s: []ff.Element{\n", name)
+	for _, v := range values {
+		n, ok := new(big.Int).SetString(strings.TrimPrefix(v, "0x"), 16) //nolint:gomnd
+		if !ok || n.Cmp(ff.Modulus()) >= 0 {
+			log.Fatalf("invalid constant %q", v)
+		}
+		// SetBigInt stores the element in Montgomery form.
+		e := ff.NewElement().SetBigInt(n)
+		fmt.Fprintf(b, "{%#016x, %#016x, %#016x, %#016x},\n", e[0], e[1], e[2], e[3])
+	}
+	b.WriteString("},\n")
+}
@@ -0,0 +1,93 @@
+package poseidon
+
+import "math/big"
+
+// grainLFSR is the Grain LFSR used by the reference implementation of
+// Poseidon (https://extgit.iaik.tugraz.at/krypto/hadeshash) to generate the
+// round constants and the MDS matrix.
+type grainLFSR struct {
+	state [80]byte
+	pos   int
+}
+
+// newGrainLFSR initializes the LFSR for a prime field of n bits, an x^alpha
+// S-box, width t, rf full rounds and rp partial rounds.
+func newGrainLFSR(n, t, rf, rp int) *grainLFSR {
+	g := &grainLFSR{}
+	i := 0
+	push := func(v, bits int) {
+		for j := bits - 1; j >= 0; j-- {
+			g.state[i] = byte(v>>uint(j)) & 1
+			i++
+		}
+	}
+	push(1, 2)  // prime field
+	push(0, 4)  // x^alpha S-box
+	push(n, 12) //nolint:gomnd
+	push(t, 12) //nolint:gomnd
+	push(rf, 10)
+	push(rp, 10)
+	push(1<<30-1, 30)
+	for j := 0; j < 160; j++ {
+		g.next()
+	}
+	return g
+}
+
+// next updates the LFSR and returns the new bit.
+func (g *grainLFSR) next() byte {
+	s := func(i int) byte {
+		return g.state[(g.pos+i)%len(g.state)]
+	}
+	b := s(62) ^ s(51) ^ s(38) ^ s(23) ^ s(13) ^ s(0)
+	g.state[g.pos] = b
+	g.pos = (g.pos + 1) % len(g.state)
+	return b
+}
+
+// bit returns the next output bit.  The bits are taken in pairs, and the
+// second one is output only if the first one is set.
+func (g *grainLFSR) bit() byte {
+	for {
+		if g.next() == 1 {
+			return g.next()
+		}
+		g.next()
+	}
+}
+
+// bits returns an integer made of the next n output bits, most significant
+// first.
+func (g *grainLFSR) bits(n int) *big.Int {
+	v := new(big.Int)
+	for i := 0; i < n; i++ {
+		v.Lsh(v, 1)
+		if g.bit() == 1 {
+			v.SetBit(v, 0, 1)
+		}
+	}
+	return v
+}
+
+// fieldElement returns the next element of the field of modulus p,
+// discarding the integers of p.BitLen() bits that are not lower than p.
+func (g *grainLFSR) fieldElement(p *big.Int) *big.Int {
+	for {
+		v := g.bits(p.BitLen())
+		if v.Cmp(p) < 0 {
+			return v
+		}
+	}
+}
+
+// roundConstants returns rounds vectors of t round constants.
+func (g *grainLFSR) roundConstants(p *big.Int, t, rounds int) [][]*big.Int {
+	rc := make([][]*big.Int, rounds)
+	for r := range rc {
+		rc[r] = make([]*big.Int, t)
+		for i := range rc[r] {
+			rc[r][i] = g.fieldElement(p)
+		}
+	}
+	return rc
+}
@@ -0,0 +1,90 @@
+package poseidon
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+)
+
+// Hasher computes the Poseidon hash of a byte stream, implementing
+// hash.Hash.  The bytes are packed into field elements as they are written,
+// and the digest is the same as the one of HashBytesX for the concatenation
+// of all the written bytes, encoded as a 32 byte big-endian integer, so it
+// matches the JS implementations too.  Like HashBytesX, the empty stream has
+// the digest of the stream "\x00".
+type Hasher struct {
+	frameSize int
+	st        *spongeState
+	buf       [spongeChunkSize]byte
+	n         int
+}
+
+var _ hash.Hash = (*Hasher)(nil)
+
+// NewHasher returns a new Hasher with the frame size of 16 used by
+// HashBytes.
+func NewHasher() *Hasher {
+	h, _ := NewHasherX(spongeFrameSize)
+	return h
+}
+
+// NewHasherX returns a new Hasher with the frame size used by HashBytesX.
+func NewHasherX(frameSize int) (*Hasher, error) {
+	if frameSize < 2 || frameSize > len(NROUNDSP) {
+		return nil, errors.New("incorrect frame size")
+	}
+	h := &Hasher{frameSize: frameSize}
+	h.Reset()
+	return h, nil
+}
+
+// Write absorbs the bytes of p.  It never returns an error.
+func (h *Hasher) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		c := copy(h.buf[h.n:], p)
+		h.n += c
+		p = p[c:]
+		if h.n == spongeChunkSize {
+			h.st.absorb(chunkElementBE(h.buf[:]))
+			h.n = 0
+		}
+	}
+	return n, nil
+}
+
+// Digest returns the hash of the bytes written so far as a field element.
+// It does not change the state of the Hasher.  The packed chunks and the
+// intermediate hashes are always inside the field, so it cannot fail.
+func (h *Hasher) Digest() *big.Int {
+	st := *h.st
+	if h.n > 0 || st.k == 0 {
+		st.absorb(chunkElementBE(h.buf[:h.n]))
+	}
+	d := st.digest()
+	return d.ToBigIntRegular(new(big.Int))
+}
+
+// Sum appends the 32 byte big-endian encoding of the digest to b and returns
+// the resulting slice.  It does not change the state of the Hasher.
+func (h *Hasher) Sum(b []byte) []byte {
+	var buf [32]byte
+	h.Digest().FillBytes(buf[:])
+	return append(b, buf[:]...)
+}
+
+// Reset resets the Hasher to its initial state.
+func (h *Hasher) Reset() {
+	h.st = newSpongeState(h.frameSize)
+	h.n = 0
+}
+
+// Size returns the number of bytes Sum will return, 32.
+func (h *Hasher) Size() int {
+	return 32 //nolint:gomnd
+}
+
+// BlockSize returns the number of bytes packed into each field element, 31.
+func (h *Hasher) BlockSize() int {
+	return spongeChunkSize
+}
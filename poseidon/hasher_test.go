@@ -0,0 +1,106 @@
+package poseidon
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasher(t *testing.T) {
+	msg := make([]byte, 1000)
+	for i := range msg {
+		msg[i] = byte(i * 13)
+	}
+	for _, n := range []int{0, 1, 30, 31, 32, 465, 466, 496, 1000} {
+		expected, err := HashBytes(msg[:n])
+		require.NoError(t, err)
+
+		// One write
+		h := NewHasher()
+		_, err = h.Write(msg[:n])
+		require.NoError(t, err)
+		assert.Equal(t, expected, h.Digest(), "len %d", n)
+
+		// Many small writes
+		h.Reset()
+		for i := 0; i < n; i += 7 {
+			end := i + 7
+			if end > n {
+				end = n
+			}
+			_, err = h.Write(msg[i:end])
+			require.NoError(t, err)
+		}
+		sum := h.Sum(nil)
+		assert.Equal(t, expected, new(big.Int).SetBytes(sum), "len %d", n)
+		assert.Len(t, sum, h.Size())
+		// Sum does not change the state
+		assert.Equal(t, sum, h.Sum(nil))
+	}
+
+	// Streaming with io.Copy
+	h := NewHasher()
+	_, err := io.Copy(h, bytes.NewReader(msg))
+	require.NoError(t, err)
+	expected, err := HashBytes(msg)
+	require.NoError(t, err)
+	assert.Equal(t, expected, new(big.Int).SetBytes(h.Sum(nil)))
+
+	// Other frame sizes
+	h, err = NewHasherX(5)
+	require.NoError(t, err)
+	_, err = h.Write(msg)
+	require.NoError(t, err)
+	expected, err = HashBytesX(msg, 5)
+	require.NoError(t, err)
+	assert.Equal(t, expected, new(big.Int).SetBytes(h.Sum(nil)))
+	_, err = NewHasherX(17)
+	assert.Error(t, err)
+
+	// Empty stream
+	h = NewHasher()
+	expected, err = HashBytes([]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, expected, new(big.Int).SetBytes(h.Sum(nil)))
+
+	assert.Equal(t, 31, h.BlockSize())
+	assert.Equal(t, []byte("prefix"), h.Sum([]byte("prefix"))[:6])
+}
+
+func TestHasherSplitPoints(t *testing.T) {
+	msg := make([]byte, 100)
+	for i := range msg {
+		msg[i] = byte(i*7 + 1)
+	}
+	for _, n := range []int{0, 1, 31, 62, 100} {
+		expected, err := HashBytesX(msg[:n], 3)
+		require.NoError(t, err)
+		// The digest does not depend on how the stream is split in writes.
+		for split := 0; split <= n; split++ {
+			h, err := NewHasherX(3)
+			require.NoError(t, err)
+			_, err = h.Write(msg[:split])
+			require.NoError(t, err)
+			_, err = h.Write(msg[split:n])
+			require.NoError(t, err)
+			assert.Equal(t, expected, h.Digest(), "len %d, split %d", n, split)
+		}
+	}
+}
+
+func TestHasherEmpty(t *testing.T) {
+	// As with HashBytes, the empty stream has the digest of "\x00".
+	empty := NewHasher()
+	zero := NewHasher()
+	_, err := zero.Write([]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, empty.Sum(nil), zero.Sum(nil))
+
+	expected, err := HashBytes(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, empty.Digest())
+}
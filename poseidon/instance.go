@@ -0,0 +1,350 @@
+package poseidon
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+)
+
+// Params are the parameters of a Poseidon instance.
+type Params struct {
+	// Modulus is the prime modulus of the field.  If nil, it is the BN254
+	// scalar field of ff.
+	Modulus *big.Int
+	// T is the width of the permutation, the number of inputs of the hash
+	// plus one.
+	T int
+	// Alpha is the exponent of the S-box, x^Alpha.  It must be coprime with
+	// Modulus-1.  If zero, it is 5.
+	Alpha int
+	// SecurityLevel is the security level in bits used to compute the
+	// number of rounds.  If zero, it is 128.
+	SecurityLevel int
+	// RoundsF and RoundsP are the numbers of full and partial rounds.  If
+	// both are zero, they are computed with RoundNumbers.
+	RoundsF, RoundsP int
+}
+
+// BuiltinParams returns the parameters of the built-in Poseidon constants of
+// width t, the ones of circomlib.
+func BuiltinParams(t int) (Params, error) {
+	if t < 2 || t > maxWidth {
+		return Params{}, fmt.Errorf("invalid width %d, min 2, max %d", t, maxWidth)
+	}
+	return Params{
+		Modulus:       ff.Modulus(),
+		T:             t,
+		Alpha:         5, //nolint:gomnd
+		SecurityLevel: 128,
+		RoundsF:       NROUNDSF,
+		RoundsP:       NROUNDSP[t-2],
+	}, nil
+}
+
+// Instance is a Poseidon instance with constants generated as in the
+// reference implementation (https://extgit.iaik.tugraz.at/krypto/hadeshash):
+// the round constants and the MDS matrix are drawn from the Grain LFSR
+// initialized with the parameters.  The permutation is computed with
+// big.Int, without the optimizations of the built-in hash.
+type Instance struct {
+	params Params
+	rc     [][]*big.Int
+	mds    [][]*big.Int
+}
+
+// NewInstance generates the constants of the Poseidon instance with the
+// given parameters.
+func NewInstance(params Params) (*Instance, error) {
+	if params.Modulus == nil {
+		params.Modulus = ff.Modulus()
+	}
+	if params.Alpha == 0 {
+		params.Alpha = 5
+	}
+	if params.SecurityLevel == 0 {
+		params.SecurityLevel = 128
+	}
+	p := params.Modulus
+	switch {
+	case p.Cmp(big.NewInt(int64(params.T))) <= 0 || p.BitLen() >= 1<<12 ||
+		!p.ProbablyPrime(20): //nolint:gomnd
+		return nil, errors.New("invalid modulus")
+	case params.T < 2 || params.T >= 1<<12:
+		return nil, fmt.Errorf("invalid width %d", params.T)
+	case params.Alpha < 3 || new(big.Int).GCD(nil, nil, big.NewInt(int64(params.Alpha)),
+		new(big.Int).Sub(p, big.NewInt(1))).Cmp(big.NewInt(1)) != 0:
+		return nil, fmt.Errorf("invalid S-box exponent %d", params.Alpha)
+	}
+	if params.RoundsF == 0 && params.RoundsP == 0 {
+		var err error
+		params.RoundsF, params.RoundsP, err = RoundNumbers(p, params.T,
+			params.Alpha, params.SecurityLevel)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if params.RoundsF <= 0 || params.RoundsF%2 != 0 || params.RoundsF >= 1<<10 ||
+		params.RoundsP < 0 || params.RoundsP >= 1<<10 {
+		return nil, errors.New("invalid number of rounds")
+	}
+
+	g := newGrainLFSR(p.BitLen(), params.T, params.RoundsF, params.RoundsP)
+	rc := g.roundConstants(p, params.T, params.RoundsF+params.RoundsP)
+	mds := mdsMatrix(g, p, params.T)
+	return &Instance{params: params, rc: rc, mds: mds}, nil
+}
+
+// Params returns the parameters of the instance, with the default values
+// filled in.
+func (inst *Instance) Params() Params {
+	return inst.params
+}
+
+// RoundConstants returns the round constants, a vector of T elements for
+// each round.
+func (inst *Instance) RoundConstants() [][]*big.Int {
+	return copyMatrix(inst.rc)
+}
+
+// MDS returns the MDS matrix.  Each round multiplies the state, as a column
+// vector, by it.
+func (inst *Instance) MDS() [][]*big.Int {
+	return copyMatrix(inst.mds)
+}
+
+func copyMatrix(m [][]*big.Int) [][]*big.Int {
+	r := make([][]*big.Int, len(m))
+	for i := range m {
+		r[i] = make([]*big.Int, len(m[i]))
+		for j := range m[i] {
+			r[i][j] = new(big.Int).Set(m[i][j])
+		}
+	}
+	return r
+}
+
+// Permute applies the permutation to the state in place.  The state must
+// have T elements inside the field.
+func (inst *Instance) Permute(state []*big.Int) error {
+	p := inst.params.Modulus
+	if len(state) != inst.params.T {
+		return fmt.Errorf("invalid state length %d, must be %d", len(state), inst.params.T)
+	}
+	for i := range state {
+		if state[i] == nil || state[i].Sign() < 0 || state[i].Cmp(p) >= 0 {
+			return errors.New("state values not inside Finite Field")
+		}
+	}
+
+	alpha := big.NewInt(int64(inst.params.Alpha))
+	halfF := inst.params.RoundsF / 2 //nolint:gomnd
+	for r, rc := range inst.rc {
+		for i := range state {
+			state[i].Add(state[i], rc[i]).Mod(state[i], p)
+		}
+		if r < halfF || r >= halfF+inst.params.RoundsP {
+			for i := range state {
+				state[i].Exp(state[i], alpha, p)
+			}
+		} else {
+			state[0].Exp(state[0], alpha, p)
+		}
+		newState := matVecMul(inst.mds, state, p)
+		for i := range state {
+			state[i].Set(newState[i])
+		}
+	}
+	return nil
+}
+
+// Hash computes the hash of T-1 inputs: the first element of the
+// permutation of the state [0, inputs...], as Hash does with the built-in
+// constants.
+func (inst *Instance) Hash(inputs []*big.Int) (*big.Int, error) {
+	if len(inputs) != inst.params.T-1 {
+		return nil, fmt.Errorf("invalid inputs length %d, must be %d", len(inputs),
+			inst.params.T-1)
+	}
+	state := make([]*big.Int, len(inputs)+1)
+	state[0] = new(big.Int)
+	for i := range inputs {
+		if inputs[i] == nil {
+			return nil, errors.New("inputs values not inside Finite Field")
+		}
+		state[i+1] = new(big.Int).Set(inputs[i])
+	}
+	if err := inst.Permute(state); err != nil {
+		return nil, err
+	}
+	return state[0], nil
+}
+
+// optimizedConstants are the constants of the optimized permutation used by
+// the built-in hash, laid out as in constantsData, with the matrices stored
+// by rows and not transposed.
+type optimizedConstants struct {
+	c []*big.Int
+	s []*big.Int
+	p [][]*big.Int
+}
+
+// optimize computes the constants of the optimized permutation equivalent to
+// the instance, following the optimizations of
+// https://extgit.iaik.tugraz.at/krypto/hadeshash (poseidonperm_*_optimized):
+// the round constants are moved backwards through the MDS matrix, so that
+// the partial rounds only add a constant to the first element, and the MDS
+// matrix of each partial round is split into a sparse matrix and a matrix
+// that is moved to the previous round.
+func (inst *Instance) optimize() (*optimizedConstants, error) {
+	p := inst.params.Modulus
+	t := inst.params.T
+	halfF := inst.params.RoundsF / 2 //nolint:gomnd
+	rp := inst.params.RoundsP
+	mdsInv := matInverse(inst.mds, p)
+	if mdsInv == nil {
+		return nil, errors.New("singular MDS matrix")
+	}
+
+	// N * y + e = N * (y + N^-1 * e): the first element of N^-1 * e is added
+	// after the S-box of the partial round, and the others are added to the
+	// round constants of the round.
+	partial := make([]*big.Int, rp)
+	e := inst.rc[halfF+rp]
+	for r := halfF + rp - 1; r >= halfF; r-- {
+		d := matVecMul(mdsInv, e, p)
+		partial[r-halfF] = d[0]
+		e = make([]*big.Int, t)
+		e[0] = inst.rc[r][0]
+		for i := 1; i < t; i++ {
+			e[i] = new(big.Int).Add(inst.rc[r][i], d[i])
+			e[i].Mod(e[i], p)
+		}
+	}
+	// The full rounds add N^-1 times the constants of the next round after
+	// their S-boxes.
+	c := append([]*big.Int{}, inst.rc[0]...)
+	for r := 1; r < halfF; r++ {
+		c = append(c, matVecMul(mdsInv, inst.rc[r], p)...)
+	}
+	c = append(c, matVecMul(mdsInv, e, p)...)
+	c = append(c, partial...)
+	for r := halfF + rp + 1; r < len(inst.rc); r++ {
+		c = append(c, matVecMul(mdsInv, inst.rc[r], p)...)
+	}
+
+	// From the last partial round, split A = [[a, v], [w, Â]] as
+	// A = [[a, v * Â^-1], [w, I]] * [[1, 0], [0, Â]], where the second
+	// matrix commutes with the partial S-box and is merged into the MDS
+	// matrix of the previous round.
+	sparse := make([]*big.Int, 0, (2*t-1)*rp) //nolint:gomnd
+	a := inst.mds
+	for r := rp - 1; r >= 0; r-- {
+		mi := identity(t)
+		for i := 1; i < t; i++ {
+			for j := 1; j < t; j++ {
+				mi[i][j] = a[i][j]
+			}
+		}
+		miInv := matInverse(mi, p)
+		if miInv == nil {
+			return nil, errors.New("singular MDS submatrix")
+		}
+		row := matVecMul(transpose(miInv), a[0], p)
+		s := append([]*big.Int{}, row...)
+		for i := 1; i < t; i++ {
+			s = append(s, a[i][0])
+		}
+		sparse = append(s, sparse...)
+		a = matMul(mi, inst.mds, p)
+	}
+	return &optimizedConstants{c: c, s: sparse, p: a}, nil
+}
+
+// CheckBuiltinConstants regenerates the instances of the built-in Poseidon
+// constants, for all the widths, and checks that the built-in numbers of
+// rounds are at least the secure ones, that all the built-in constants, the
+// MDS matrix and the optimized round constants and matrices, are the ones
+// computed from the generated instance, and that the built-in optimized
+// permutation gives the same result as the generated instance.
+func CheckBuiltinConstants() error {
+	for t := 2; t <= maxWidth; t++ {
+		params, err := BuiltinParams(t)
+		if err != nil {
+			return err
+		}
+		if err := checkBuiltinConstants(params); err != nil {
+			return fmt.Errorf("width %d: %w", t, err)
+		}
+	}
+	return nil
+}
+
+func checkBuiltinConstants(params Params) error {
+	t := params.T
+	rf, rp, err := RoundNumbers(params.Modulus, t, params.Alpha, params.SecurityLevel)
+	if err != nil {
+		return err
+	}
+	if rf != params.RoundsF || rp > params.RoundsP {
+		return fmt.Errorf("insecure number of rounds, need %d and %d", rf, rp)
+	}
+
+	inst, err := NewInstance(params)
+	if err != nil {
+		return err
+	}
+	opt, err := inst.optimize()
+	if err != nil {
+		return err
+	}
+	cs := getConstants(t)
+	if !equalElements(cs.c, opt.c) {
+		return errors.New("round constants mismatch")
+	}
+	if !equalElements(cs.s, opt.s) {
+		return errors.New("sparse matrices mismatch")
+	}
+	// The built-in matrices are transposed.
+	mdsT, pT := transpose(inst.mds), transpose(opt.p)
+	for i := 0; i < t; i++ {
+		if !equalElements(cs.m[i], mdsT[i]) {
+			return errors.New("MDS matrix mismatch")
+		}
+		if !equalElements(cs.p[i], pT[i]) {
+			return errors.New("pre-sparse matrix mismatch")
+		}
+	}
+
+	state := make([]*big.Int, t)
+	builtin := make([]ff.Element, t)
+	for i := range state {
+		state[i] = big.NewInt(int64(i))
+		builtin[i].SetUint64(uint64(i))
+	}
+	if err := inst.Permute(state); err != nil {
+		return err
+	}
+	permute(builtin)
+	for i := range state {
+		if builtin[i].ToBigIntRegular(new(big.Int)).Cmp(state[i]) != 0 {
+			return errors.New("permutation mismatch")
+		}
+	}
+	return nil
+}
+
+// equalElements returns whether the elements es are equal to the integers
+// vs.
+func equalElements(es []ff.Element, vs []*big.Int) bool {
+	if len(es) != len(vs) {
+		return false
+	}
+	for i := range es {
+		if es[i].ToBigIntRegular(new(big.Int)).Cmp(vs[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package poseidon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBuiltinConstants(t *testing.T) {
+	if testing.Short() {
+		for _, width := range []int{2, 3, 6} {
+			params, err := BuiltinParams(width)
+			require.NoError(t, err)
+			assert.NoError(t, checkBuiltinConstants(params), "t = %d", width)
+		}
+		return
+	}
+	assert.NoError(t, CheckBuiltinConstants())
+}
+
+func TestOptimize(t *testing.T) {
+	params, err := BuiltinParams(3)
+	require.NoError(t, err)
+	inst, err := NewInstance(params)
+	require.NoError(t, err)
+	cs := getConstants(3)
+	opt, err := inst.optimize()
+	require.NoError(t, err)
+	assert.True(t, equalElements(cs.c, opt.c))
+	assert.True(t, equalElements(cs.s, opt.s))
+
+	// A change in any round constant, including the ones of the partial
+	// and last rounds, changes the optimized round constants.
+	last := len(inst.rc) - 1
+	halfF := params.RoundsF / 2
+	for _, rc := range []*big.Int{inst.rc[last][2], inst.rc[halfF+1][1], inst.rc[halfF][0]} {
+		rc.Add(rc, big.NewInt(1))
+		opt, err = inst.optimize()
+		require.NoError(t, err)
+		assert.False(t, equalElements(cs.c, opt.c))
+		rc.Sub(rc, big.NewInt(1))
+	}
+}
+
+func TestRoundNumbers(t *testing.T) {
+	q := ff.Modulus()
+	for width := 2; width <= 5; width++ {
+		rf, rp, err := RoundNumbers(q, width, 5, 128)
+		require.NoError(t, err)
+		assert.Equal(t, 8, rf)
+		assert.Equal(t, 56, rp)
+	}
+	rf, rp, err := RoundNumbers(q, 8, 5, 128)
+	require.NoError(t, err)
+	assert.Equal(t, 8, rf)
+	assert.Equal(t, 57, rp)
+
+	_, _, err = RoundNumbers(q, 1, 5, 128)
+	assert.Error(t, err)
+	_, _, err = RoundNumbers(q, 3, 2, 128)
+	assert.Error(t, err)
+}
+
+func TestInstanceHash(t *testing.T) {
+	params, err := BuiltinParams(3)
+	require.NoError(t, err)
+	inst, err := NewInstance(params)
+	require.NoError(t, err)
+	assert.Equal(t, params, inst.Params())
+
+	inputs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	h, err := inst.Hash(inputs)
+	require.NoError(t, err)
+	want, err := Hash(inputs)
+	require.NoError(t, err)
+	assert.Equal(t, want, h)
+	assert.Equal(t,
+		"7853200120776062878684798364095072458815029376092732009249414926327459813530",
+		h.String())
+
+	_, err = inst.Hash(inputs[:1])
+	assert.Error(t, err)
+	_, err = inst.Hash([]*big.Int{big.NewInt(1), ff.Modulus()})
+	assert.Error(t, err)
+}
+
+func TestNewInstanceDefaults(t *testing.T) {
+	inst, err := NewInstance(Params{T: 4})
+	require.NoError(t, err)
+	params := inst.Params()
+	assert.Equal(t, ff.Modulus(), params.Modulus)
+	assert.Equal(t, 5, params.Alpha)
+	assert.Equal(t, 128, params.SecurityLevel)
+	assert.Equal(t, 8, params.RoundsF)
+	assert.Equal(t, 56, params.RoundsP)
+	assert.Len(t, inst.RoundConstants(), 64)
+	assert.Len(t, inst.MDS(), 4)
+
+	// The returned constants are copies.
+	inst.MDS()[0][0].SetInt64(0)
+	inst.RoundConstants()[0][0].SetInt64(0)
+	other, err := NewInstance(Params{T: 4})
+	require.NoError(t, err)
+	assert.Equal(t, other.MDS(), inst.MDS())
+	assert.Equal(t, other.RoundConstants(), inst.RoundConstants())
+}
+
+func TestNewInstanceSmallField(t *testing.T) {
+	// The Goldilocks field, with the x^7 S-box.
+	p := new(big.Int).SetUint64(0xffffffff00000001)
+	inst, err := NewInstance(Params{Modulus: p, T: 12, Alpha: 7})
+	require.NoError(t, err)
+	assert.True(t, isSecureMDS(inst.MDS(), p))
+
+	state := make([]*big.Int, 12)
+	for i := range state {
+		state[i] = big.NewInt(int64(i))
+	}
+	require.NoError(t, inst.Permute(state))
+	for i := range state {
+		assert.Less(t, state[i].Cmp(p), 0)
+	}
+}
+
+func TestNewInstanceInvalid(t *testing.T) {
+	for _, params := range []Params{
+		{T: 1},
+		{T: 3, Alpha: 3}, // 3 divides p-1
+		{T: 3, Alpha: 2},
+		{T: 3, Modulus: big.NewInt(15)},
+		{T: 3, RoundsF: 7, RoundsP: 56},
+		{T: 3, RoundsF: 8, RoundsP: -1},
+	} {
+		_, err := NewInstance(params)
+		assert.Error(t, err, "%+v", params)
+	}
+}
+
+func TestIsSecureMDS(t *testing.T) {
+	p := ff.Modulus()
+	// The identity leaves the last elements of the state out of the S-boxes.
+	assert.False(t, isSecureMDS(identity(3), p))
+
+	// e0 is an eigenvector: no cyclic vector.
+	m := [][]*big.Int{
+		{big.NewInt(2), big.NewInt(1), big.NewInt(1)},
+		{big.NewInt(0), big.NewInt(3), big.NewInt(1)},
+		{big.NewInt(0), big.NewInt(1), big.NewInt(5)},
+	}
+	assert.False(t, mdsAlgorithm2(m, p))
+
+	params, err := BuiltinParams(3)
+	require.NoError(t, err)
+	inst, err := NewInstance(params)
+	require.NoError(t, err)
+	assert.True(t, isSecureMDS(inst.MDS(), p))
+}
+
+func TestPolyRoots(t *testing.T) {
+	p := big.NewInt(101)
+	// (x - 3)(x - 7)(x^2 + 2), where -2 is not a square mod 101.
+	f := []*big.Int{big.NewInt(1)}
+	for _, g := range [][]*big.Int{
+		{big.NewInt(98), big.NewInt(1)},
+		{big.NewInt(94), big.NewInt(1)},
+		{big.NewInt(2), big.NewInt(0), big.NewInt(1)},
+	} {
+		f = polyMul(f, g, p)
+	}
+	roots := polyRoots(f, p)
+	require.Len(t, roots, 2)
+	assert.ElementsMatch(t, []int64{3, 7}, []int64{roots[0].Int64(), roots[1].Int64()})
+}
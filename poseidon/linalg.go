@@ -0,0 +1,404 @@
+package poseidon
+
+import "math/big"
+
+// Linear algebra and polynomials over the prime field of modulus p, used to
+// check the security of the MDS matrices.  Vectors and matrices are slices
+// of reduced *big.Int, and polynomials are slices of coefficients, lowest
+// degree first, without leading zeros.
+
+func identity(t int) [][]*big.Int {
+	m := make([][]*big.Int, t)
+	for i := range m {
+		m[i] = make([]*big.Int, t)
+		for j := range m[i] {
+			m[i][j] = new(big.Int)
+		}
+		m[i][i].SetInt64(1)
+	}
+	return m
+}
+
+func matMul(a, b [][]*big.Int, p *big.Int) [][]*big.Int {
+	r := make([][]*big.Int, len(a))
+	tmp := new(big.Int)
+	for i := range a {
+		r[i] = make([]*big.Int, len(b[0]))
+		for j := range r[i] {
+			r[i][j] = new(big.Int)
+			for k := range b {
+				r[i][j].Add(r[i][j], tmp.Mul(a[i][k], b[k][j]))
+			}
+			r[i][j].Mod(r[i][j], p)
+		}
+	}
+	return r
+}
+
+func matVecMul(m [][]*big.Int, v []*big.Int, p *big.Int) []*big.Int {
+	r := make([]*big.Int, len(m))
+	tmp := new(big.Int)
+	for i := range m {
+		r[i] = new(big.Int)
+		for j := range v {
+			r[i].Add(r[i], tmp.Mul(m[i][j], v[j]))
+		}
+		r[i].Mod(r[i], p)
+	}
+	return r
+}
+
+func transpose(m [][]*big.Int) [][]*big.Int {
+	r := make([][]*big.Int, len(m[0]))
+	for i := range r {
+		r[i] = make([]*big.Int, len(m))
+		for j := range r[i] {
+			r[i][j] = m[j][i]
+		}
+	}
+	return r
+}
+
+// matInverse returns the inverse of the square matrix m, or nil if m is
+// singular.
+func matInverse(m [][]*big.Int, p *big.Int) [][]*big.Int {
+	n := len(m)
+	// Gauss-Jordan elimination on [m | I]
+	rows := make([][]*big.Int, n)
+	for i := range m {
+		rows[i] = make([]*big.Int, 2*n)
+		for j := 0; j < n; j++ {
+			rows[i][j] = new(big.Int).Set(m[i][j])
+			rows[i][n+j] = new(big.Int)
+		}
+		rows[i][n+i].SetInt64(1)
+	}
+	tmp := new(big.Int)
+	for c := 0; c < n; c++ {
+		k := c
+		for k < n && rows[k][c].Sign() == 0 {
+			k++
+		}
+		if k == n {
+			return nil
+		}
+		rows[c], rows[k] = rows[k], rows[c]
+		inv := new(big.Int).ModInverse(rows[c][c], p)
+		for j := range rows[c] {
+			rows[c][j].Mul(rows[c][j], inv).Mod(rows[c][j], p)
+		}
+		for i := range rows {
+			if i == c || rows[i][c].Sign() == 0 {
+				continue
+			}
+			f := new(big.Int).Set(rows[i][c])
+			for j := range rows[i] {
+				rows[i][j].Sub(rows[i][j], tmp.Mul(f, rows[c][j])).Mod(rows[i][j], p)
+			}
+		}
+	}
+	for i := range rows {
+		rows[i] = rows[i][n:]
+	}
+	return rows
+}
+
+// isScalar returns whether m is a multiple of the identity.
+func isScalar(m [][]*big.Int) bool {
+	for i := range m {
+		for j := range m[i] {
+			if i == j && m[i][j].Cmp(m[0][0]) != 0 || i != j && m[i][j].Sign() != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// vecSpace is the subspace spanned by a set of vectors, kept in row echelon
+// form.
+type vecSpace struct {
+	p      *big.Int
+	basis  [][]*big.Int
+	pivots []int
+}
+
+func newVecSpace(p *big.Int, vs ...[]*big.Int) *vecSpace {
+	s := &vecSpace{p: p}
+	for _, v := range vs {
+		s.add(v)
+	}
+	return s
+}
+
+// add adds v to the subspace, and returns whether its dimension grows.
+func (s *vecSpace) add(v []*big.Int) bool {
+	w := make([]*big.Int, len(v))
+	for i := range v {
+		w[i] = new(big.Int).Set(v[i])
+	}
+	tmp := new(big.Int)
+	// Each vector of the basis is zero at the pivots of the previous ones.
+	for k, b := range s.basis {
+		c := new(big.Int).Set(w[s.pivots[k]])
+		if c.Sign() == 0 {
+			continue
+		}
+		for i := range w {
+			w[i].Sub(w[i], tmp.Mul(c, b[i])).Mod(w[i], s.p)
+		}
+	}
+	for i := range w {
+		if w[i].Sign() != 0 {
+			inv := new(big.Int).ModInverse(w[i], s.p)
+			for j := range w {
+				w[j].Mul(w[j], inv).Mod(w[j], s.p)
+			}
+			s.basis = append(s.basis, w)
+			s.pivots = append(s.pivots, i)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *vecSpace) dim() int {
+	return len(s.basis)
+}
+
+// sum returns the subspace spanned by s and the vectors vs.
+func (s *vecSpace) sum(vs [][]*big.Int) *vecSpace {
+	r := newVecSpace(s.p, s.basis...)
+	for _, v := range vs {
+		r.add(v)
+	}
+	return r
+}
+
+// intersectionDim returns the dimension of the intersection of s and the
+// subspace spanned by the independent vectors vs.
+func (s *vecSpace) intersectionDim(vs [][]*big.Int) int {
+	return s.dim() + len(vs) - s.sum(vs).dim()
+}
+
+// kernel returns a basis of the right kernel of m, which has n columns.
+func kernel(m [][]*big.Int, n int, p *big.Int) [][]*big.Int {
+	// Reduced row echelon form
+	rows := make([][]*big.Int, len(m))
+	for i := range m {
+		rows[i] = make([]*big.Int, n)
+		for j := range rows[i] {
+			rows[i][j] = new(big.Int).Set(m[i][j])
+		}
+	}
+	tmp := new(big.Int)
+	var pivots []int
+	r := 0
+	for c := 0; c < n && r < len(rows); c++ {
+		k := r
+		for k < len(rows) && rows[k][c].Sign() == 0 {
+			k++
+		}
+		if k == len(rows) {
+			continue
+		}
+		rows[r], rows[k] = rows[k], rows[r]
+		inv := new(big.Int).ModInverse(rows[r][c], p)
+		for j := range rows[r] {
+			rows[r][j].Mul(rows[r][j], inv).Mod(rows[r][j], p)
+		}
+		for i := range rows {
+			if i == r || rows[i][c].Sign() == 0 {
+				continue
+			}
+			f := new(big.Int).Set(rows[i][c])
+			for j := range rows[i] {
+				rows[i][j].Sub(rows[i][j], tmp.Mul(f, rows[r][j])).Mod(rows[i][j], p)
+			}
+		}
+		pivots = append(pivots, c)
+		r++
+	}
+
+	isPivot := make([]bool, n)
+	for _, c := range pivots {
+		isPivot[c] = true
+	}
+	var basis [][]*big.Int
+	for free := 0; free < n; free++ {
+		if isPivot[free] {
+			continue
+		}
+		v := make([]*big.Int, n)
+		for j := range v {
+			v[j] = new(big.Int)
+		}
+		v[free].SetInt64(1)
+		for i, c := range pivots {
+			v[c].Neg(rows[i][free]).Mod(v[c], p)
+		}
+		basis = append(basis, v)
+	}
+	return basis
+}
+
+// charPoly returns the characteristic polynomial of m, computed with the
+// Faddeev-LeVerrier algorithm, so p must be larger than the size of m.
+func charPoly(m [][]*big.Int, p *big.Int) []*big.Int {
+	n := len(m)
+	c := make([]*big.Int, n+1)
+	c[n] = big.NewInt(1)
+	mk := make([][]*big.Int, n)
+	for i := range mk {
+		mk[i] = make([]*big.Int, n)
+		for j := range mk[i] {
+			mk[i][j] = new(big.Int)
+		}
+	}
+	for k := 1; k <= n; k++ {
+		mk = matMul(m, mk, p)
+		for i := 0; i < n; i++ {
+			mk[i][i].Add(mk[i][i], c[n-k+1]).Mod(mk[i][i], p)
+		}
+		am := matMul(m, mk, p)
+		tr := new(big.Int)
+		for i := 0; i < n; i++ {
+			tr.Add(tr, am[i][i])
+		}
+		inv := new(big.Int).ModInverse(big.NewInt(int64(k)), p)
+		c[n-k] = tr.Neg(tr).Mul(tr, inv).Mod(tr, p)
+	}
+	return c
+}
+
+func polyTrim(a []*big.Int) []*big.Int {
+	for len(a) > 0 && a[len(a)-1].Sign() == 0 {
+		a = a[:len(a)-1]
+	}
+	return a
+}
+
+func polyMul(a, b []*big.Int, p *big.Int) []*big.Int {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	r := make([]*big.Int, len(a)+len(b)-1)
+	for i := range r {
+		r[i] = new(big.Int)
+	}
+	tmp := new(big.Int)
+	for i := range a {
+		for j := range b {
+			r[i+j].Add(r[i+j], tmp.Mul(a[i], b[j]))
+		}
+	}
+	for i := range r {
+		r[i].Mod(r[i], p)
+	}
+	return polyTrim(r)
+}
+
+// polyDivMod returns the quotient and the remainder of a divided by b.
+func polyDivMod(a, b []*big.Int, p *big.Int) ([]*big.Int, []*big.Int) {
+	r := make([]*big.Int, len(a))
+	for i := range a {
+		r[i] = new(big.Int).Set(a[i])
+	}
+	r = polyTrim(r)
+	if len(r) < len(b) {
+		return nil, r
+	}
+	q := make([]*big.Int, len(r)-len(b)+1)
+	inv := new(big.Int).ModInverse(b[len(b)-1], p)
+	tmp := new(big.Int)
+	for len(r) >= len(b) {
+		d := len(r) - len(b)
+		c := new(big.Int).Mul(r[len(r)-1], inv)
+		c.Mod(c, p)
+		q[d] = c
+		for i := range b {
+			r[d+i].Sub(r[d+i], tmp.Mul(c, b[i])).Mod(r[d+i], p)
+		}
+		r = polyTrim(r)
+	}
+	for i := range q {
+		if q[i] == nil {
+			q[i] = new(big.Int)
+		}
+	}
+	return q, r
+}
+
+func polySub(a, b []*big.Int, p *big.Int) []*big.Int {
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+	r := make([]*big.Int, n)
+	for i := range r {
+		r[i] = new(big.Int)
+		if i < len(a) {
+			r[i].Add(r[i], a[i])
+		}
+		if i < len(b) {
+			r[i].Sub(r[i], b[i])
+		}
+		r[i].Mod(r[i], p)
+	}
+	return polyTrim(r)
+}
+
+// polyPowMod returns a^e mod f.
+func polyPowMod(a []*big.Int, e *big.Int, f []*big.Int, p *big.Int) []*big.Int {
+	_, a = polyDivMod(a, f, p)
+	r := []*big.Int{big.NewInt(1)}
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		_, r = polyDivMod(polyMul(r, r, p), f, p)
+		if e.Bit(i) == 1 {
+			_, r = polyDivMod(polyMul(r, a, p), f, p)
+		}
+	}
+	return r
+}
+
+func polyGCD(a, b []*big.Int, p *big.Int) []*big.Int {
+	a, b = polyTrim(a), polyTrim(b)
+	for len(b) > 0 {
+		_, r := polyDivMod(a, b, p)
+		a, b = b, r
+	}
+	return a
+}
+
+// polyRoots returns the distinct roots in the field of the polynomial f, of
+// positive degree, for an odd p.
+func polyRoots(f []*big.Int, p *big.Int) []*big.Int {
+	// g is the product of (x - r) for the roots r of f.
+	x := []*big.Int{big.NewInt(0), big.NewInt(1)}
+	g := polyGCD(f, polySub(polyPowMod(x, p, f, p), x, p), p)
+	return splitRoots(g, p)
+}
+
+// splitRoots returns the roots of g, a product of distinct linear factors,
+// splitting it with gcd(g, (x+a)^((p-1)/2) - 1) for a = 0, 1, ...
+func splitRoots(g []*big.Int, p *big.Int) []*big.Int {
+	switch len(g) {
+	case 0, 1:
+		return nil
+	case 2: //nolint:gomnd
+		// g = g1 x + g0
+		r := new(big.Int).ModInverse(g[1], p)
+		r.Mul(r, g[0]).Neg(r).Mod(r, p)
+		return []*big.Int{r}
+	}
+	e := new(big.Int).Rsh(p, 1)
+	one := []*big.Int{big.NewInt(1)}
+	for a := int64(0); ; a++ {
+		h := polyPowMod([]*big.Int{big.NewInt(a), big.NewInt(1)}, e, g, p)
+		d := polyGCD(g, polySub(h, one, p), p)
+		if len(d) > 1 && len(d) < len(g) {
+			q, _ := polyDivMod(g, d, p)
+			return append(splitRoots(d, p), splitRoots(q, p)...)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package poseidon
+
+import "math/big"
+
+// mdsMatrix generates the MDS matrix of width t as the reference
+// implementation does: a Cauchy matrix M[i][j] = 1/(x_i + y_j) from 2t
+// distinct elements drawn from the Grain LFSR, which is drawn again until
+// the matrix passes the checks against invariant subspace trails of
+// isSecureMDS.
+func mdsMatrix(g *grainLFSR, p *big.Int, t int) [][]*big.Int {
+	for {
+		m := cauchyMatrix(g, p, t)
+		if m != nil && isSecureMDS(m, p) {
+			return m
+		}
+	}
+}
+
+// cauchyMatrix returns a Cauchy matrix drawn from the Grain LFSR, or nil if
+// some x_i + y_j is zero.
+func cauchyMatrix(g *grainLFSR, p *big.Int, t int) [][]*big.Int {
+	var xy []*big.Int
+	for xy == nil {
+		xy = make([]*big.Int, 2*t)
+		seen := make(map[string]bool, len(xy))
+		for i := range xy {
+			xy[i] = g.bits(p.BitLen())
+			xy[i].Mod(xy[i], p)
+			seen[xy[i].String()] = true
+		}
+		if len(seen) != len(xy) {
+			// Draw again if there are repeated elements.
+			xy = nil
+		}
+	}
+	xs, ys := xy[:t], xy[t:]
+	m := make([][]*big.Int, t)
+	for i := range m {
+		m[i] = make([]*big.Int, t)
+		for j := range m[i] {
+			m[i][j] = new(big.Int).Add(xs[i], ys[j])
+			m[i][j].Mod(m[i][j], p)
+			if m[i][j].Sign() == 0 {
+				return nil
+			}
+			m[i][j].ModInverse(m[i][j], p)
+		}
+	}
+	return m
+}
+
+// isSecureMDS returns whether the matrix passes the three algorithms of the
+// reference implementation (https://eprint.iacr.org/2020/500) that check
+// that there are no invariant subspace trails for the partial rounds, with a
+// single S-box per round.
+func isSecureMDS(m [][]*big.Int, p *big.Int) bool {
+	return mdsAlgorithm1(m, p) && mdsAlgorithm2(m, p) && mdsAlgorithm3(m, p)
+}
+
+// unitVector returns the i-th vector of the canonical basis of F^t.
+func unitVector(t, i int) []*big.Int {
+	v := make([]*big.Int, t)
+	for j := range v {
+		v[j] = new(big.Int)
+	}
+	v[i].SetInt64(1)
+	return v
+}
+
+// mdsAlgorithm1 checks that for r = 1 to t-1 rounds, the subspace of the
+// states that are not affected by the S-boxes of r rounds does not contain
+// eigenvectors of M^r and is not invariant under M^j, j = 1..r.
+func mdsAlgorithm1(m [][]*big.Int, p *big.Int) bool {
+	t := len(m)
+	powers := [][][]*big.Int{identity(t), m} // powers[i] = M^i
+	for i := 2; i <= t; i++ {
+		powers = append(powers, matMul(powers[i-1], m, p))
+	}
+
+	for r := 1; r < t; r++ {
+		mr := powers[r]
+		if isScalar(mr) {
+			return false
+		}
+
+		// S: the states with a zero first element after each of the first
+		// r-1 rounds, and before the r-th one.
+		var s *vecSpace
+		if r == 1 {
+			s = newVecSpace(p)
+			for i := 1; i < t; i++ {
+				s.add(unitVector(t, i))
+			}
+		} else {
+			rows := make([][]*big.Int, r-1)
+			for i := range rows {
+				rows[i] = powers[i+1][0][1:]
+			}
+			s = newVecSpace(p)
+			for _, v := range kernel(rows, t-1, p) {
+				s.add(append([]*big.Int{new(big.Int)}, v...))
+			}
+		}
+
+		// Eigenvectors of M^r in S
+		for _, lambda := range polyRoots(charPoly(mr, p), p) {
+			a := make([][]*big.Int, t)
+			for i := range a {
+				a[i] = make([]*big.Int, t)
+				for j := range a[i] {
+					a[i][j] = new(big.Int).Set(mr[i][j])
+				}
+				a[i][i].Sub(a[i][i], lambda).Mod(a[i][i], p)
+			}
+			if s.intersectionDim(kernel(a, t, p)) >= 1 {
+				return false
+			}
+		}
+
+		// Invariance of S under M^j
+		for j := 1; j <= r; j++ {
+			image := make([][]*big.Int, len(s.basis))
+			for i, v := range s.basis {
+				image[i] = matVecMul(powers[j], v, p)
+			}
+			if s.sum(image).dim() == s.dim() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mdsAlgorithm2 checks that the first vector of the canonical basis is a
+// cyclic vector of M: its images by the powers of M span the whole space.
+func mdsAlgorithm2(m [][]*big.Int, p *big.Int) bool {
+	t := len(m)
+	v := unitVector(t, 0)
+	s := newVecSpace(p, v)
+	for s.dim() < t {
+		v = matVecMul(m, v, p)
+		if !s.add(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// mdsAlgorithm3 runs mdsAlgorithm2 with M^r for r = 2..4t.
+func mdsAlgorithm3(m [][]*big.Int, p *big.Int) bool {
+	t := len(m)
+	mr := m
+	for r := 2; r <= 4*t; r++ {
+		mr = matMul(mr, m, p)
+		if !mdsAlgorithm2(mr, p) {
+			return false
+		}
+	}
+	return true
+}
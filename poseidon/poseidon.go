@@ -4,123 +4,148 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/iden3/go-iden3-crypto/v2/ff"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
 )
 
+//go:generate go run gen_constants.go testdata/poseidon_constants_opt.json
+
 // NROUNDSF constant from Poseidon paper
 const NROUNDSF = 8
 
 // NROUNDSP constant from Poseidon paper
 var NROUNDSP = []int{56, 57, 56, 60, 60, 63, 64, 63, 60, 66, 60, 65, 70, 60, 64, 68}
 
-func zero() *ff.Element {
-	return ff.NewElement()
+// constantsLit are the constants of a width as generated in constants.go,
+// with the matrices stored by rows.
+type constantsLit struct {
+	c, s, m, p []ff.Element
 }
 
-var big5 = big.NewInt(5)
+// constants are the constants of a width, ready to be used by the
+// permutation.
+type constants struct {
+	c []ff.Element
+	s []ff.Element
+	m [][]ff.Element
+	p [][]ff.Element
+}
 
-// exp5 performs x^5 mod p
-// https://eprint.iacr.org/2019/458.pdf page 8
-func exp5(a *ff.Element) {
-	a.Exp(*a, big5)
+var (
+	constantsOnce [len(constantsData)]sync.Once
+	constantsT    [len(constantsData)]*constants
+)
+
+// getConstants returns the constants of width t, loading them the first time
+// they are used.  It is safe for concurrent use.
+func getConstants(t int) *constants {
+	i := t - 2 //nolint:gomnd
+	constantsOnce[i].Do(func() {
+		constantsT[i] = loadConstants(t)
+	})
+	return constantsT[i]
 }
 
-// exp5state perform exp5 for whole state
-func exp5state(state []*ff.Element) {
-	for i := 0; i < len(state); i++ {
-		exp5(state[i])
+// loadConstants builds the constants of width t from constantsData.
+func loadConstants(t int) *constants {
+	d := &constantsData[t-2]
+	return &constants{
+		c: d.c,
+		s: d.s,
+		m: matrixRows(d.m, t),
+		p: matrixRows(d.p, t),
 	}
 }
 
-// ark computes Add-Round Key, from the paper https://eprint.iacr.org/2019/458.pdf
-func ark(state, c []*ff.Element, it int) {
-	for i := 0; i < len(state); i++ {
-		state[i].Add(state[i], c[it+i])
+// matrixRows splits a t x t matrix stored by rows into its rows.
+func matrixRows(m []ff.Element, t int) [][]ff.Element {
+	rows := make([][]ff.Element, t)
+	for i := range rows {
+		rows[i] = m[i*t : (i+1)*t : (i+1)*t]
 	}
+	return rows
 }
 
-// mix returns [[matrix]] * [vector]
-func mix(state []*ff.Element, t int, m [][]*ff.Element) []*ff.Element {
-	mul := zero()
-	newState := make([]*ff.Element, t)
-	for i := 0; i < t; i++ {
-		newState[i] = zero()
-	}
-	for i := 0; i < len(state); i++ {
-		newState[i].SetUint64(0)
-		for j := 0; j < len(state); j++ {
-			mul.Mul(m[j][i], state[j])
-			newState[i].Add(newState[i], mul)
-		}
-	}
-	return newState
+// maxWidth is the largest width of the permutation.  The state is kept in
+// arrays of this size, so that hashing does not allocate.
+const maxWidth = 17
+
+// exp5 performs x^5 mod p as x * (x^2)^2
+// https://eprint.iacr.org/2019/458.pdf page 8
+func exp5(a *ff.Element) {
+	var a4 ff.Element
+	a4.Square(a)
+	a4.Square(&a4)
+	a.Mul(a, &a4)
 }
 
-// HashWithState computes the Poseidon hash for the given inputs and initState
-func HashWithState(inpBI []*big.Int, initState *big.Int) (*big.Int, error) {
-	res, err := HashWithStateEx(inpBI, initState, 1)
-	if err != nil {
-		return nil, err
+// exp5state perform exp5 for whole state
+func exp5state(state []ff.Element) {
+	for i := range state {
+		exp5(&state[i])
 	}
-	return res[0], nil
 }
 
-func HashWithStateEx(inpBI []*big.Int, initState *big.Int, nOuts int) ([]*big.Int, error) {
-	t := len(inpBI) + 1
-	if len(inpBI) == 0 || len(inpBI) > len(NROUNDSP) {
-		return nil, fmt.Errorf("invalid inputs length %d, max %d", len(inpBI), len(NROUNDSP))
-	}
-	if !utils.CheckBigIntArrayInField(inpBI) {
-		return nil, errors.New("inputs values not inside Finite Field")
+// ark computes Add-Round Key, from the paper https://eprint.iacr.org/2019/458.pdf
+func ark(state []ff.Element, c []ff.Element, it int) {
+	for i := range state {
+		state[i].Add(&state[i], &c[it+i])
 	}
-	if nOuts < 1 || nOuts > t {
-		return nil, fmt.Errorf("invalid nOuts %d, min 1, max %d", nOuts, t)
+}
+
+// mix sets state to [[matrix]] * [vector]
+func mix(state []ff.Element, m [][]ff.Element) {
+	var newState [maxWidth]ff.Element
+	var mul ff.Element
+	for i := range state {
+		for j := range state {
+			mul.Mul(&m[j][i], &state[j])
+			newState[i].Add(&newState[i], &mul)
+		}
 	}
-	inp := utils.BigIntArrayToElementArray(inpBI)
+	copy(state, newState[:len(state)])
+}
 
+// permute applies the Poseidon permutation of width t = len(state) to the
+// state in place.  t must be between 2 and maxWidth.
+func permute(state []ff.Element) {
+	t := len(state)
 	nRoundsF := NROUNDSF
 	nRoundsP := NROUNDSP[t-2]
-	C := c.c[t-2]
-	S := c.s[t-2]
-	M := c.m[t-2]
-	P := c.p[t-2]
-
-	state := make([]*ff.Element, t)
-	if !utils.CheckBigIntInField(initState) {
-		return nil, errors.New("initState values not inside Finite Field")
-	}
-
-	state[0] = ff.NewElement().SetBigInt(initState)
-	copy(state[1:], inp)
+	cs := getConstants(t)
+	C := cs.c
+	S := cs.s
+	M := cs.m
+	P := cs.p
 
 	ark(state, C, 0)
 
 	for i := 0; i < nRoundsF/2-1; i++ {
 		exp5state(state)
 		ark(state, C, (i+1)*t)
-		state = mix(state, t, M)
+		mix(state, M)
 	}
 	exp5state(state)
 	ark(state, C, (nRoundsF/2)*t)
-	state = mix(state, t, P)
+	mix(state, P)
 
-	mul := zero()
+	var mul, newState0 ff.Element
 	for i := 0; i < nRoundsP; i++ {
-		exp5(state[0])
-		state[0].Add(state[0], C[(nRoundsF/2+1)*t+i])
-
-		mul.SetZero()
-		newState0 := zero()
-		for j := 0; j < len(state); j++ {
-			mul.Mul(S[(t*2-1)*i+j], state[j])
-			newState0.Add(newState0, mul)
+		exp5(&state[0])
+		state[0].Add(&state[0], &C[(nRoundsF/2+1)*t+i])
+
+		s := S[(t*2-1)*i : (t*2-1)*(i+1)]
+		newState0.SetZero()
+		for j := range state {
+			mul.Mul(&s[j], &state[j])
+			newState0.Add(&newState0, &mul)
 		}
 
 		for k := 1; k < t; k++ {
-			mul.SetZero()
-			state[k] = state[k].Add(state[k], mul.Mul(state[0], S[(t*2-1)*i+t+k-1]))
+			mul.Mul(&state[0], &s[t+k-1])
+			state[k].Add(&state[k], &mul)
 		}
 		state[0] = newState0
 	}
@@ -128,16 +153,68 @@ func HashWithStateEx(inpBI []*big.Int, initState *big.Int, nOuts int) ([]*big.In
 	for i := 0; i < nRoundsF/2-1; i++ {
 		exp5state(state)
 		ark(state, C, (nRoundsF/2+1)*t+nRoundsP+i*t)
-		state = mix(state, t, M)
+		mix(state, M)
 	}
 	exp5state(state)
-	state = mix(state, t, M)
+	mix(state, M)
+}
+
+// Permute applies the Poseidon permutation of width t = len(state) to the
+// state in place.  The supported widths are 2 to 17, as for Hash, which
+// returns the first element of the permutation of the state [0, inputs...].
+func Permute(state []ff.Element) error {
+	if len(state) < 2 || len(state) > maxWidth {
+		return fmt.Errorf("invalid state length %d, min 2, max %d", len(state),
+			maxWidth)
+	}
+	permute(state)
+	return nil
+}
+
+// checkLengths checks the number of inputs and outputs of a hash.
+func checkLengths(nInputs, nOuts int) error {
+	if nInputs == 0 || nInputs > len(NROUNDSP) {
+		return fmt.Errorf("invalid inputs length %d, max %d", nInputs, len(NROUNDSP))
+	}
+	if nOuts < 1 || nOuts > nInputs+1 {
+		return fmt.Errorf("invalid nOuts %d, min 1, max %d", nOuts, nInputs+1)
+	}
+	return nil
+}
+
+// HashWithState computes the Poseidon hash for the given inputs and initState
+func HashWithState(inpBI []*big.Int, initState *big.Int) (*big.Int, error) {
+	res, err := HashWithStateEx(inpBI, initState, 1)
+	if err != nil {
+		return nil, err
+	}
+	return res[0], nil
+}
+
+func HashWithStateEx(inpBI []*big.Int, initState *big.Int, nOuts int) ([]*big.Int, error) {
+	t := len(inpBI) + 1
+	if err := checkLengths(len(inpBI), nOuts); err != nil {
+		return nil, err
+	}
+	if !utils.CheckBigIntArrayInField(inpBI) {
+		return nil, errors.New("inputs values not inside Finite Field")
+	}
+	if !utils.CheckBigIntInField(initState) {
+		return nil, errors.New("initState values not inside Finite Field")
+	}
+
+	var st [maxWidth]ff.Element
+	state := st[:t]
+	state[0].SetBigInt(initState)
+	for i := range inpBI {
+		state[i+1].SetBigInt(inpBI[i])
+	}
+
+	permute(state)
 
 	r := make([]*big.Int, nOuts)
 	for i := 0; i < nOuts; i++ {
-		rE := state[i]
-		r[i] = big.NewInt(0)
-		rE.ToBigIntRegular(r[i])
+		r[i] = state[i].ToBigIntRegular(new(big.Int))
 	}
 	return r, nil
 }
@@ -152,3 +229,50 @@ func Hash(inpBI []*big.Int) (*big.Int, error) {
 func HashEx(inpBI []*big.Int, nOuts int) ([]*big.Int, error) {
 	return HashWithStateEx(inpBI, big.NewInt(0), nOuts)
 }
+
+// permuteElements applies the permutation to the state [initState, inputs...],
+// stored in st, and returns it.
+func permuteElements(st *[maxWidth]ff.Element, inputs []ff.Element,
+	initState *ff.Element) []ff.Element {
+	state := st[:len(inputs)+1]
+	state[0] = *initState
+	copy(state[1:], inputs)
+	permute(state)
+	return state
+}
+
+// HashElementsWithState computes the Poseidon hash for the given inputs and
+// initState.  It is HashWithState for field elements, which does not allocate.
+func HashElementsWithState(inputs []ff.Element, initState ff.Element) (ff.Element, error) {
+	if err := checkLengths(len(inputs), 1); err != nil {
+		return ff.Element{}, err
+	}
+	var st [maxWidth]ff.Element
+	return permuteElements(&st, inputs, &initState)[0], nil
+}
+
+// HashElementsWithStateEx computes the Poseidon hash for the given inputs and
+// initState, and returns the first nOuts outputs that include intermediate
+// states.  It is HashWithStateEx for field elements.
+func HashElementsWithStateEx(inputs []ff.Element, initState ff.Element,
+	nOuts int) ([]ff.Element, error) {
+	if err := checkLengths(len(inputs), nOuts); err != nil {
+		return nil, err
+	}
+	var st [maxWidth]ff.Element
+	state := permuteElements(&st, inputs, &initState)
+	return append([]ff.Element(nil), state[:nOuts]...), nil
+}
+
+// HashElements computes the Poseidon hash for the given inputs.  It is Hash
+// for field elements, which does not allocate.
+func HashElements(inputs []ff.Element) (ff.Element, error) {
+	return HashElementsWithState(inputs, ff.Element{})
+}
+
+// HashElementsEx computes the Poseidon hash for the given inputs and returns
+// the first nOuts outputs that include intermediate states.  It is HashEx for
+// field elements.
+func HashElementsEx(inputs []ff.Element, nOuts int) ([]ff.Element, error) {
+	return HashElementsWithStateEx(inputs, ff.Element{}, nOuts)
+}
@@ -1,9 +1,11 @@
 package poseidon
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/iden3/go-iden3-crypto/v2/ff"
 	"github.com/iden3/go-iden3-crypto/v2/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -484,3 +486,101 @@ func BenchmarkPoseidonHash16Inputs(b *testing.B) {
 		_, _ = Hash(bigArray16)
 	}
 }
+
+func TestPermuteNoAllocs(t *testing.T) {
+	for width := 2; width <= maxWidth; width++ {
+		state := elems(0, uint64(width))
+		getConstants(width)
+		allocs := testing.AllocsPerRun(10, func() {
+			_ = Permute(state)
+		})
+		assert.Zero(t, allocs, "width %d", width)
+	}
+}
+
+func BenchmarkPermute(b *testing.B) {
+	for _, width := range []int{3, 6, 9, 17} {
+		state := elems(0, uint64(width))
+		b.Run(fmt.Sprintf("t=%d", width), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = Permute(state)
+			}
+		})
+	}
+}
+
+func BenchmarkHashWithStateEx(b *testing.B) {
+	for _, n := range []int{2, 5, 16} {
+		inputs := bigInts(1, int64(n+1))
+		b.Run(fmt.Sprintf("%d inputs", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = HashWithStateEx(inputs, big.NewInt(0), 1)
+			}
+		})
+	}
+}
+
+func TestHashElements(t *testing.T) {
+	initState := ff.NewElementFromUint64(7)
+	for n := 1; n <= len(NROUNDSP); n++ {
+		inputs := elems(1, uint64(n+1))
+
+		h, err := HashElements(inputs)
+		require.NoError(t, err)
+		expected, err := Hash(toBigInts(inputs))
+		require.NoError(t, err)
+		assert.Equal(t, expected, h.ToBigIntRegular(new(big.Int)), "%d inputs", n)
+
+		h, err = HashElementsWithState(inputs, initState)
+		require.NoError(t, err)
+		expected, err = HashWithState(toBigInts(inputs), big.NewInt(7))
+		require.NoError(t, err)
+		assert.Equal(t, expected, h.ToBigIntRegular(new(big.Int)), "%d inputs", n)
+
+		hs, err := HashElementsEx(inputs, n+1)
+		require.NoError(t, err)
+		expectedEx, err := HashEx(toBigInts(inputs), n+1)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEx, toBigInts(hs), "%d inputs", n)
+
+		hs, err = HashElementsWithStateEx(inputs, initState, 1)
+		require.NoError(t, err)
+		expectedEx, err = HashWithStateEx(toBigInts(inputs), big.NewInt(7), 1)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEx, toBigInts(hs), "%d inputs", n)
+	}
+
+	// The inputs are not modified
+	inputs := elems(1, 4)
+	_, err := HashElements(inputs)
+	require.NoError(t, err)
+	assert.Equal(t, elems(1, 4), inputs)
+
+	_, err = HashElements(nil)
+	assert.Error(t, err)
+	_, err = HashElements(elems(0, 17))
+	assert.Error(t, err)
+	_, err = HashElementsEx(elems(1, 3), 0)
+	assert.Error(t, err)
+	_, err = HashElementsEx(elems(1, 3), 4)
+	assert.Error(t, err)
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = HashElements(inputs)
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkHashElements(b *testing.B) {
+	for _, n := range []int{2, 5, 16} {
+		inputs := elems(1, uint64(n+1))
+		b.Run(fmt.Sprintf("%d inputs", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = HashElements(inputs)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package poseidon
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// RoundNumbers returns the numbers of full and partial rounds for a Poseidon
+// instance of width t with an x^alpha S-box over the prime field of modulus
+// p, for the given security level in bits.  They are computed as in the
+// reference implementation (calc_round_numbers.py): the cheapest numbers, in
+// number of S-boxes, that resist the statistical, interpolation and Gröbner
+// basis attacks of the Poseidon paper and
+// https://eprint.iacr.org/2023/537, plus a security margin of 2 full
+// rounds and 7.5% partial rounds.
+func RoundNumbers(p *big.Int, t, alpha, securityLevel int) (roundsF, roundsP int, err error) {
+	if t < 2 || alpha < 3 || securityLevel < 1 {
+		return 0, 0, errors.New("invalid Poseidon parameters")
+	}
+	minCost := math.MaxInt
+	for rp := 1; rp < 500; rp++ {
+		for rf := 4; rf < 100; rf += 2 {
+			if !roundsSecure(p, t, rf, rp, alpha, securityLevel) {
+				continue
+			}
+			rf2 := rf + 2                              //nolint:gomnd
+			rp2 := int(math.Ceil(float64(rp) * 1.075)) //nolint:gomnd
+			if cost := t*rf2 + rp2; cost < minCost ||
+				cost == minCost && rf2 < roundsF {
+				minCost, roundsF, roundsP = cost, rf2, rp2
+			}
+			// More full rounds only increase the cost.
+			break
+		}
+	}
+	if roundsF == 0 {
+		return 0, 0, errors.New("no secure round numbers found")
+	}
+	return roundsF, roundsP, nil
+}
+
+// roundsSecure returns whether rf full rounds and rp partial rounds resist
+// the attacks considered by the reference implementation.
+func roundsSecure(p *big.Int, t, rf, rp, alpha, securityLevel int) bool {
+	n := float64(p.BitLen())
+	logp := log2(p)
+	m := float64(securityLevel)
+	fT, fRP, fAlpha := float64(t), float64(rp), float64(alpha)
+	logAlpha := func(x float64) float64 {
+		return math.Log(x) / math.Log(fAlpha)
+	}
+
+	// Statistical
+	rf1 := 10.0
+	if m <= math.Floor(logp-(fAlpha-1)/2)*(fT+1) {
+		rf1 = 6
+	}
+	// Interpolation
+	rf2 := 1 + math.Ceil(logAlpha(2)*math.Min(m, n)) + math.Ceil(logAlpha(fT)) - fRP
+	// Gröbner basis
+	rf3 := logAlpha(2)*math.Min(m, logp) - fRP
+	rf4 := fT - 1 + logAlpha(2)*math.Min(m/(fT+1), logp/2) - fRP
+	rf5 := (fT - 2 + m/(2*math.Log2(fAlpha)) - fRP) / (fT - 1)
+	rfMax := math.Max(math.Max(math.Ceil(rf1), math.Ceil(rf2)),
+		math.Max(math.Max(math.Ceil(rf3), math.Ceil(rf4)), math.Ceil(rf5)))
+	if float64(rf) < rfMax {
+		return false
+	}
+
+	// https://eprint.iacr.org/2023/537
+	r := math.Floor(fT / 3)
+	fRF := float64(rf)
+	over := (fRF-1)*fT + fRP + r + r*(fRF/2) + fRP + fAlpha
+	under := r*(fRF/2) + fRP + fAlpha
+	return math.Ceil(2*log2Binomial(over, under)) >= m
+}
+
+// log2 returns the base 2 logarithm of x.
+func log2(x *big.Int) float64 {
+	shift := x.BitLen() - 64 //nolint:gomnd
+	if shift < 0 {
+		shift = 0
+	}
+	f, _ := new(big.Float).SetInt(new(big.Int).Rsh(x, uint(shift))).Float64()
+	return math.Log2(f) + float64(shift)
+}
+
+// log2Binomial returns the base 2 logarithm of the binomial coefficient
+// (n, k).
+func log2Binomial(n, k float64) float64 {
+	ln, _ := math.Lgamma(n + 1)
+	lk, _ := math.Lgamma(k + 1)
+	lnk, _ := math.Lgamma(n - k + 1)
+	return (ln - lk - lnk) / math.Ln2
+}
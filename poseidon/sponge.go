@@ -0,0 +1,229 @@
+package poseidon
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// spongeFrameSize is the default number of inputs absorbed by each Poseidon
+// call in SpongeHash and HashBytes, using the widest permutation.
+const spongeFrameSize = 16
+
+// spongeChunkSize is the number of bytes packed into each field element by
+// HashBytes.  31 bytes always fit in the field.
+const spongeChunkSize = 31
+
+// spongePadByte is appended to the byte strings hashed by HashBytesPadded,
+// so that strings that differ in trailing zero bytes are packed differently.
+const spongePadByte = 0x01
+
+// SpongeHash computes the Poseidon hash of an arbitrary number of inputs,
+// absorbing them in frames of 16 inputs.  It is SpongeHashX with a frame
+// size of 16.
+func SpongeHash(inputs []*big.Int) (*big.Int, error) {
+	return SpongeHashX(inputs, spongeFrameSize)
+}
+
+// SpongeHashX computes the Poseidon hash of an arbitrary number of inputs,
+// absorbing them in frames of frameSize inputs.  After the first frame, the
+// first input of every frame is the hash of the previous one, so each frame
+// carries frameSize-1 new inputs.  The last frame is padded with zeros, and
+// it is not hashed again when the inputs fill it.  The construction only uses
+// the regular Poseidon hash, so it can be reproduced inside a circuit, and
+// matches spongeHashX of the JS implementations (iden3 js-crypto).
+//
+// Because of the zero padding, inputs that differ in trailing zeros can have
+// the same hash.  SpongeHashPaddedX does not have this ambiguity.
+func SpongeHashX(inputs []*big.Int, frameSize int) (*big.Int, error) {
+	st, err := absorbInputs(inputs, frameSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("empty inputs")
+	}
+	d := st.digest()
+	return d.ToBigIntRegular(new(big.Int)), nil
+}
+
+// SpongeHashPadded computes the Poseidon hash of an arbitrary number of
+// inputs like SpongeHash, but with an injective padding.  It is
+// SpongeHashPaddedX with a frame size of 16.
+func SpongeHashPadded(inputs []*big.Int) (*big.Int, error) {
+	return SpongeHashPaddedX(inputs, spongeFrameSize)
+}
+
+// SpongeHashPaddedX computes the Poseidon hash of an arbitrary number of
+// inputs, absorbing them in frames of frameSize inputs as SpongeHashX does,
+// but the inputs are padded with a one and then zeros up to the end of the
+// last frame (10* padding), so that inputs that differ in trailing zeros have
+// different hashes.  Empty inputs are valid.  The result is different from
+// SpongeHashX, and from the JS implementations.
+func SpongeHashPaddedX(inputs []*big.Int, frameSize int) (*big.Int, error) {
+	st, err := absorbInputs(inputs, frameSize)
+	if err != nil {
+		return nil, err
+	}
+	d := st.paddedDigest()
+	return d.ToBigIntRegular(new(big.Int)), nil
+}
+
+func absorbInputs(inputs []*big.Int, frameSize int) (*spongeState, error) {
+	if frameSize < 2 || frameSize > len(NROUNDSP) {
+		return nil, errors.New("incorrect frame size")
+	}
+	if !utils.CheckBigIntArrayInField(inputs) {
+		return nil, errors.New("inputs values not inside Finite Field")
+	}
+	st := newSpongeState(frameSize)
+	var e ff.Element
+	for i := range inputs {
+		st.absorb(e.SetBigInt(inputs[i]))
+	}
+	return st, nil
+}
+
+// spongeState is the state of the sponge after absorbing some inputs: the
+// current frame, filled up to k, and the hash of the last full frame.  The
+// frame elements from k on are zero.  dirty is set when the current frame
+// holds inputs that have not been hashed yet.
+type spongeState struct {
+	frame [maxWidth - 1]ff.Element
+	size  int
+	k     int
+	dirty bool
+	h     ff.Element
+}
+
+func newSpongeState(frameSize int) *spongeState {
+	return &spongeState{size: frameSize}
+}
+
+// absorb adds x to the current frame, hashing it when it is full.
+func (st *spongeState) absorb(x *ff.Element) {
+	st.frame[st.k] = *x
+	st.k++
+	st.dirty = true
+	if st.k < st.size {
+		return
+	}
+	st.h = st.hashFrame()
+	st.frame = [maxWidth - 1]ff.Element{st.h}
+	st.k = 1
+	st.dirty = false
+}
+
+// hashFrame returns the Poseidon hash of the current frame.
+func (st *spongeState) hashFrame() ff.Element {
+	var s [maxWidth]ff.Element
+	var zero ff.Element
+	return permuteElements(&s, st.frame[:st.size], &zero)[0]
+}
+
+// digest returns the hash of the absorbed inputs with the zero padding of
+// SpongeHashX.  At least one input must have been absorbed.
+func (st *spongeState) digest() ff.Element {
+	if !st.dirty {
+		return st.h
+	}
+	return st.hashFrame()
+}
+
+// paddedDigest returns the hash of the absorbed inputs with the 10* padding
+// of SpongeHashPaddedX, without modifying the state.
+func (st *spongeState) paddedDigest() ff.Element {
+	padded := *st
+	padded.frame[padded.k].SetOne()
+	return padded.hashFrame()
+}
+
+// HashBytes computes the Poseidon hash of a byte string.  It is HashBytesX
+// with a frame size of 16.
+func HashBytes(msg []byte) (*big.Int, error) {
+	return HashBytesX(msg, spongeFrameSize)
+}
+
+// HashBytesX computes the Poseidon hash of a byte string, as hashBytesX of
+// the JS implementations (iden3 js-crypto) does.  The string is split into
+// chunks of 31 bytes, each one read as a big-endian integer, with the last,
+// shorter, chunk padded with zeros on the right, and the resulting field
+// elements are hashed with SpongeHashX.  The JS implementations do not
+// define the hash of the empty string; here it is hashed as a single zero
+// chunk, like the string "\x00".
+//
+// Strings that differ in trailing zero bytes within the last chunk have the
+// same hash.  HashBytesPaddedX does not have this ambiguity.
+func HashBytesX(msg []byte, frameSize int) (*big.Int, error) {
+	if frameSize < 2 || frameSize > len(NROUNDSP) {
+		return nil, errors.New("incorrect frame size")
+	}
+	st := newSpongeState(frameSize)
+	for len(msg) >= spongeChunkSize {
+		st.absorb(chunkElementBE(msg[:spongeChunkSize]))
+		msg = msg[spongeChunkSize:]
+	}
+	if len(msg) > 0 || st.k == 0 {
+		st.absorb(chunkElementBE(msg))
+	}
+	d := st.digest()
+	return d.ToBigIntRegular(new(big.Int)), nil
+}
+
+// HashBytesPadded computes the Poseidon hash of a byte string like
+// HashBytes, but with an injective padding.  It is HashBytesPaddedX with a
+// frame size of 16.
+func HashBytesPadded(msg []byte) (*big.Int, error) {
+	return HashBytesPaddedX(msg, spongeFrameSize)
+}
+
+// HashBytesPaddedX computes the Poseidon hash of a byte string.  The byte
+// 0x01 is appended to the string, which is then split into chunks of 31
+// bytes, each one read as a little-endian integer (so the last, shorter,
+// chunk is implicitly padded with zeros), and the resulting field elements
+// are hashed with SpongeHashPaddedX.  Strings that differ in trailing zero
+// bytes have different hashes, and the empty string is a valid input.  The
+// result is different from HashBytesX, and from the JS implementations.
+func HashBytesPaddedX(msg []byte, frameSize int) (*big.Int, error) {
+	if frameSize < 2 || frameSize > len(NROUNDSP) {
+		return nil, errors.New("incorrect frame size")
+	}
+	st := newSpongeState(frameSize)
+	for len(msg) >= spongeChunkSize {
+		st.absorb(chunkElementLE(msg[:spongeChunkSize]))
+		msg = msg[spongeChunkSize:]
+	}
+	st.absorb(lastChunkElementLE(msg))
+	d := st.paddedDigest()
+	return d.ToBigIntRegular(new(big.Int)), nil
+}
+
+// chunkElementBE returns the field element of a chunk of up to 31 bytes,
+// padded with zeros on the right to 31 bytes and read as a big-endian
+// integer.
+func chunkElementBE(b []byte) *ff.Element {
+	var buf [spongeChunkSize]byte
+	copy(buf[:], b)
+	return new(ff.Element).SetBytes(buf[:])
+}
+
+// chunkElementLE returns the field element of a chunk of up to 31 bytes,
+// read as a little-endian integer.
+func chunkElementLE(b []byte) *ff.Element {
+	var be [spongeChunkSize]byte
+	for i := range b {
+		be[len(be)-1-i] = b[i]
+	}
+	return new(ff.Element).SetBytes(be[:])
+}
+
+// lastChunkElementLE returns the field element of the last chunk of a byte
+// string, of up to 30 bytes, after appending the padding byte to it.
+func lastChunkElementLE(b []byte) *ff.Element {
+	var buf [spongeChunkSize]byte
+	n := copy(buf[:], b)
+	buf[n] = spongePadByte
+	return chunkElementLE(buf[:n+1])
+}
@@ -0,0 +1,292 @@
+package poseidon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bigInts(from, to int64) []*big.Int {
+	r := make([]*big.Int, 0, to-from)
+	for i := from; i < to; i++ {
+		r = append(r, big.NewInt(i))
+	}
+	return r
+}
+
+func zeroPad(in []*big.Int, n int) []*big.Int {
+	r := append([]*big.Int{}, in...)
+	for len(r) < n {
+		r = append(r, big.NewInt(0))
+	}
+	return r
+}
+
+// pad appends a one and then zeros to in up to n elements, the padding of
+// the last frame of SpongeHashPaddedX.
+func pad(in []*big.Int, n int) []*big.Int {
+	return zeroPad(append(append([]*big.Int{}, in...), big.NewInt(1)), n)
+}
+
+func TestSpongeHash(t *testing.T) {
+	// A single frame is the Poseidon hash of the zero padded inputs
+	h, err := SpongeHash(bigInts(1, 4))
+	require.NoError(t, err)
+	expected, err := Hash(zeroPad(bigInts(1, 4), 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// A full frame is hashed once
+	h, err = SpongeHash(bigInts(0, 16))
+	require.NoError(t, err)
+	expected, err = Hash(bigInts(0, 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// The following frames are chained through their first input
+	h, err = SpongeHash(bigInts(0, 17))
+	require.NoError(t, err)
+	h0, err := Hash(bigInts(0, 16))
+	require.NoError(t, err)
+	expected, err = Hash(zeroPad([]*big.Int{h0, big.NewInt(16)}, 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	h, err = SpongeHash(bigInts(0, 31))
+	require.NoError(t, err)
+	expected, err = Hash(append([]*big.Int{h0}, bigInts(16, 31)...))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// Smaller frames
+	h, err = SpongeHashX(bigInts(0, 5), 3)
+	require.NoError(t, err)
+	h0, err = Hash(bigInts(0, 3))
+	require.NoError(t, err)
+	h1, err := Hash([]*big.Int{h0, big.NewInt(3), big.NewInt(4)})
+	require.NoError(t, err)
+	assert.Equal(t, h1, h)
+
+	_, err = SpongeHash(nil)
+	assert.Error(t, err)
+	_, err = SpongeHashX(bigInts(0, 5), 1)
+	assert.Error(t, err)
+	_, err = SpongeHashX(bigInts(0, 5), 17)
+	assert.Error(t, err)
+	_, err = SpongeHash([]*big.Int{new(big.Int).Neg(big.NewInt(1))})
+	assert.Error(t, err)
+}
+
+func TestHashBytes(t *testing.T) {
+	msg := []byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do " +
+		"eiusmod tempor incididunt ut labore et dolore magna aliqua.")
+	h, err := HashBytes(msg)
+	require.NoError(t, err)
+
+	// 31 byte big-endian chunks, the last one padded with zeros on the right
+	elems := []*big.Int{}
+	for i := 0; i < len(msg); i += 31 {
+		var chunk [31]byte
+		copy(chunk[:], msg[i:])
+		elems = append(elems, new(big.Int).SetBytes(chunk[:]))
+	}
+	expected, err := SpongeHash(elems)
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	h, err = HashBytes([]byte{0x01, 0x02})
+	require.NoError(t, err)
+	expected, err = Hash(zeroPad([]*big.Int{new(big.Int).Lsh(big.NewInt(0x0102), 29*8)}, 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// The empty string is a single zero chunk
+	h, err = HashBytes(nil)
+	require.NoError(t, err)
+	expected, err = HashBytes([]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	_, err = HashBytesX(msg, 1)
+	assert.Error(t, err)
+}
+
+// TestSpongeVectors checks HashBytes and SpongeHashX against the test
+// vectors of the sponge functions of go-iden3-crypto v0.0.17
+// (poseidon/poseidon_test.go), which iden3 js-crypto implements too.  The
+// inputs of SpongeHashX are the 31 byte big-endian chunks of the bytes,
+// without padding the last one.
+func TestSpongeVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/sponge_vectors.json")
+	require.NoError(t, err)
+	var vectors struct {
+		HashBytes []struct {
+			Bytes string
+			Hash  string
+		}
+		SpongeHashX []struct {
+			Bytes     string
+			FrameSize int
+			Hash      string
+		}
+	}
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors.HashBytes)
+	require.NotEmpty(t, vectors.SpongeHashX)
+
+	for i, v := range vectors.HashBytes {
+		msg, err := hex.DecodeString(v.Bytes)
+		require.NoError(t, err)
+		h, err := HashBytes(msg)
+		require.NoError(t, err)
+		assert.Equal(t, v.Hash, fmt.Sprintf("%064x", h), "vector %d", i)
+	}
+	for i, v := range vectors.SpongeHashX {
+		msg, err := hex.DecodeString(v.Bytes)
+		require.NoError(t, err)
+		var inputs []*big.Int
+		for j := 0; j < len(msg); j += 31 {
+			end := j + 31
+			if end > len(msg) {
+				end = len(msg)
+			}
+			inputs = append(inputs, new(big.Int).SetBytes(msg[j:end]))
+		}
+		h, err := SpongeHashX(inputs, v.FrameSize)
+		require.NoError(t, err)
+		assert.Equal(t, v.Hash, fmt.Sprintf("%064x", h), "vector %d", i)
+	}
+}
+
+func TestSpongeHashPadded(t *testing.T) {
+	// A single frame is the Poseidon hash of the padded inputs
+	h, err := SpongeHashPadded(bigInts(1, 4))
+	require.NoError(t, err)
+	expected, err := Hash(pad(bigInts(1, 4), 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// A full frame is hashed, and the padding goes in a new frame
+	h, err = SpongeHashPadded(bigInts(0, 16))
+	require.NoError(t, err)
+	h0, err := Hash(bigInts(0, 16))
+	require.NoError(t, err)
+	expected, err = Hash(pad([]*big.Int{h0}, 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// Smaller frames
+	h, err = SpongeHashPaddedX(bigInts(0, 5), 3)
+	require.NoError(t, err)
+	h0, err = Hash(bigInts(0, 3))
+	require.NoError(t, err)
+	h1, err := Hash([]*big.Int{h0, big.NewInt(3), big.NewInt(4)})
+	require.NoError(t, err)
+	expected, err = Hash(pad([]*big.Int{h1}, 3))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// Empty inputs are only padding
+	h, err = SpongeHashPadded(nil)
+	require.NoError(t, err)
+	expected, err = Hash(pad(nil, 16))
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	_, err = SpongeHashPaddedX(bigInts(0, 5), 17)
+	assert.Error(t, err)
+	_, err = SpongeHashPadded([]*big.Int{new(big.Int).Neg(big.NewInt(1))})
+	assert.Error(t, err)
+}
+
+func TestSpongeHashTrailingZeros(t *testing.T) {
+	// With the padded sponge, inputs that only differ in trailing zeros have
+	// different hashes, for all the positions in a frame.  SpongeHash does
+	// not distinguish them.
+	for n := int64(1); n <= 33; n++ {
+		h1, err := SpongeHashPadded(bigInts(1, n))
+		require.NoError(t, err)
+		h2, err := SpongeHashPadded(append(bigInts(1, n), big.NewInt(0)))
+		require.NoError(t, err)
+		assert.NotEqual(t, h1, h2, "len %d", n-1)
+	}
+	h1, err := SpongeHash(bigInts(1, 4))
+	require.NoError(t, err)
+	h2, err := SpongeHash(append(bigInts(1, 4), big.NewInt(0)))
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+}
+
+func TestHashBytesPadded(t *testing.T) {
+	msg := []byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do " +
+		"eiusmod tempor incididunt ut labore et dolore magna aliqua.")
+	h, err := HashBytesPadded(msg)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"11210978278415460164446838608312004844656734597858650576720636384017380807600",
+		h.String())
+
+	// 31 byte little-endian chunks of msg || 0x01
+	padded := append(append([]byte{}, msg...), 0x01)
+	elems := []*big.Int{}
+	for i := 0; i < len(padded); i += 31 {
+		end := i + 31
+		if end > len(padded) {
+			end = len(padded)
+		}
+		elems = append(elems, utils.SetBigIntFromLEBytes(new(big.Int), padded[i:end]))
+	}
+	expected, err := SpongeHashPadded(elems)
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	h, err = HashBytesPadded([]byte{0x01, 0x02})
+	require.NoError(t, err)
+	expected, err = SpongeHashPadded([]*big.Int{big.NewInt(0x010201)})
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// The padding byte starts a new chunk after a full one
+	h, err = HashBytesPadded(make([]byte, 31))
+	require.NoError(t, err)
+	expected, err = SpongeHashPadded([]*big.Int{big.NewInt(0), big.NewInt(1)})
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	// The empty string is the padding byte alone
+	h, err = HashBytesPadded(nil)
+	require.NoError(t, err)
+	expected, err = SpongeHashPadded([]*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	assert.Equal(t, expected, h)
+
+	_, err = HashBytesPaddedX(msg, 1)
+	assert.Error(t, err)
+}
+
+func TestHashBytesTrailingZeros(t *testing.T) {
+	msg := make([]byte, 70)
+	for i := range msg {
+		msg[i] = byte(i + 1)
+	}
+	for n := 0; n <= len(msg); n++ {
+		h1, err := HashBytesPadded(msg[:n])
+		require.NoError(t, err)
+		h2, err := HashBytesPadded(append(append([]byte{}, msg[:n]...), 0x00))
+		require.NoError(t, err)
+		assert.NotEqual(t, h1, h2, "len %d", n)
+	}
+	// HashBytes pads the last chunk with zeros
+	h1, err := HashBytes([]byte("a"))
+	require.NoError(t, err)
+	h2, err := HashBytes([]byte("a\x00"))
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+}
@@ -0,0 +1,226 @@
+// Package poseidon2 implements the Poseidon2 permutation
+// (https://eprint.iacr.org/2023/323) over the BN254 scalar field, with the
+// parameters of the HorizenLabs reference implementation
+// (https://github.com/HorizenLabs/poseidon2): x^5 S-box, 8 full rounds and
+// the widths 2, 3, 4, 8, 12 and 16.
+package poseidon2
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/iden3/go-iden3-crypto/v2/utils"
+)
+
+// NROUNDSF is the number of full rounds, half of them at the beginning of
+// the permutation and half at the end.
+const NROUNDSF = 8
+
+// NROUNDSP is the number of partial rounds of each supported width.
+var NROUNDSP = map[int]int{2: 56, 3: 56, 4: 56, 8: 57, 12: 57, 16: 57}
+
+type constants struct {
+	// rc are the round constants, t for each full round and one for each
+	// partial round, in the order they are used.
+	rc []ff.Element
+	// diagM1 is the diagonal of the internal matrix minus one.
+	diagM1 []ff.Element
+}
+
+// lazyConstants are the constants of a width, loaded the first time they are
+// used.
+type lazyConstants struct {
+	once sync.Once
+	c    *constants
+}
+
+var c = map[int]*lazyConstants{2: {}, 3: {}, 4: {}, 8: {}, 12: {}, 16: {}}
+
+// getConstants returns the constants of width t, loading them the first time
+// they are used, or false if the width is not supported.  It is safe for
+// concurrent use.
+func getConstants(t int) (*constants, bool) {
+	l, ok := c[t]
+	if !ok {
+		return nil, false
+	}
+	l.once.Do(func() {
+		l.c = loadConstants(cs[t])
+	})
+	return l.c, true
+}
+
+// loadConstants parses the hex constants of a width.
+func loadConstants(s constantsStr) *constants {
+	one := ff.NewElement().SetOne()
+	ct := &constants{
+		rc:     make([]ff.Element, len(s.rc)),
+		diagM1: make([]ff.Element, len(s.diag)),
+	}
+	for i := range s.rc {
+		setHex(&ct.rc[i], s.rc[i])
+	}
+	for i := range s.diag {
+		setHex(&ct.diagM1[i], s.diag[i])
+		ct.diagM1[i].Sub(&ct.diagM1[i], one)
+	}
+	return ct
+}
+
+func setHex(z *ff.Element, s string) {
+	b, ok := new(big.Int).SetString(s, 16) //nolint:gomnd
+	if !ok {
+		panic(fmt.Errorf("error parsing constants"))
+	}
+	z.SetBigInt(b)
+}
+
+// sbox computes x^5
+func sbox(x *ff.Element) {
+	var x2 ff.Element
+	x2.Square(x)
+	x2.Square(&x2)
+	x.Mul(x, &x2)
+}
+
+// matMul4 multiplies the 4 elements of x by the matrix
+//
+//	[5 7 1 3]
+//	[4 6 1 1]
+//	[1 3 5 7]
+//	[1 1 4 6]
+//
+// using the addition chain of the reference implementation.
+func matMul4(x []ff.Element) {
+	var t0, t1, t2, t3, t4, t5, t6, t7 ff.Element
+	t0.Add(&x[0], &x[1])
+	t1.Add(&x[2], &x[3])
+	t2.Double(&x[1]).Add(&t2, &t1)
+	t3.Double(&x[3]).Add(&t3, &t0)
+	t4.Double(&t1).Double(&t4).Add(&t4, &t3)
+	t5.Double(&t0).Double(&t5).Add(&t5, &t2)
+	t6.Add(&t3, &t5)
+	t7.Add(&t2, &t4)
+	x[0] = t6
+	x[1] = t5
+	x[2] = t7
+	x[3] = t4
+}
+
+// matMulExternal multiplies the state by the matrix of the full rounds:
+// circ(2, 1) and circ(2, 1, 1) for t = 2 and 3, and for larger widths the 4x4
+// matrix M4 applied to each chunk of 4 elements, followed by the addition to
+// each element of the sum of the elements with the same position in every
+// chunk, which is the multiplication by circ(2·M4, M4, ..., M4).
+func matMulExternal(state []ff.Element) {
+	var sum ff.Element
+	switch t := len(state); t {
+	case 2, 3: //nolint:gomnd
+		for i := range state {
+			sum.Add(&sum, &state[i])
+		}
+		for i := range state {
+			state[i].Add(&state[i], &sum)
+		}
+	case 4: //nolint:gomnd
+		matMul4(state)
+	default:
+		for i := 0; i < t; i += 4 {
+			matMul4(state[i : i+4])
+		}
+		var sums [4]ff.Element
+		for i := range state {
+			sums[i%4].Add(&sums[i%4], &state[i])
+		}
+		for i := range state {
+			state[i].Add(&state[i], &sums[i%4])
+		}
+	}
+}
+
+// matMulInternal multiplies the state by the matrix of the partial rounds,
+// the matrix of ones plus diag(diagM1).
+func matMulInternal(state, diagM1 []ff.Element) {
+	var sum, tmp ff.Element
+	for i := range state {
+		sum.Add(&sum, &state[i])
+	}
+	switch len(state) {
+	case 2: //nolint:gomnd
+		// diagM1 = [1, 2]
+		state[0].Add(&state[0], &sum)
+		state[1].Double(&state[1]).Add(&state[1], &sum)
+	case 3: //nolint:gomnd
+		// diagM1 = [1, 1, 2]
+		state[0].Add(&state[0], &sum)
+		state[1].Add(&state[1], &sum)
+		state[2].Double(&state[2]).Add(&state[2], &sum)
+	default:
+		for i := range state {
+			tmp.Mul(&state[i], &diagM1[i])
+			state[i].Add(&tmp, &sum)
+		}
+	}
+}
+
+// fullRound adds the round constants rc to all the state, applies the S-box
+// to all of it and multiplies it by the external matrix.
+func fullRound(state, rc []ff.Element) {
+	for i := range state {
+		state[i].Add(&state[i], &rc[i])
+		sbox(&state[i])
+	}
+	matMulExternal(state)
+}
+
+// Permute applies the Poseidon2 permutation of width t = len(state) to the
+// state in place.  The supported widths are 2, 3, 4, 8, 12 and 16.
+func Permute(state []ff.Element) error {
+	t := len(state)
+	ct, ok := getConstants(t)
+	if !ok {
+		return fmt.Errorf("invalid state length %d, must be 2, 3, 4, 8, 12 or 16", t)
+	}
+	rc := ct.rc
+
+	matMulExternal(state)
+	for i := 0; i < NROUNDSF/2; i++ {
+		fullRound(state, rc[:t])
+		rc = rc[t:]
+	}
+	for i := 0; i < NROUNDSP[t]; i++ {
+		state[0].Add(&state[0], &rc[i])
+		sbox(&state[0])
+		matMulInternal(state, ct.diagM1)
+	}
+	rc = rc[NROUNDSP[t]:]
+	for i := 0; i < NROUNDSF/2; i++ {
+		fullRound(state, rc[:t])
+		rc = rc[t:]
+	}
+	return nil
+}
+
+// Hash computes the Poseidon2 hash of the inputs: the first element of the
+// permutation of the state [0, inputs...].  The number of inputs must be 1,
+// 2, 3, 7, 11 or 15.
+func Hash(inpBI []*big.Int) (*big.Int, error) {
+	if _, ok := c[len(inpBI)+1]; !ok {
+		return nil, fmt.Errorf("invalid inputs length %d, must be 1, 2, 3, 7, 11 or 15",
+			len(inpBI))
+	}
+	if !utils.CheckBigIntArrayInField(inpBI) {
+		return nil, errors.New("inputs values not inside Finite Field")
+	}
+	state := make([]ff.Element, len(inpBI)+1)
+	for i := range inpBI {
+		state[i+1].SetBigInt(inpBI[i])
+	}
+	if err := Permute(state); err != nil {
+		return nil, err
+	}
+	return state[0].ToBigIntRegular(new(big.Int)), nil
+}
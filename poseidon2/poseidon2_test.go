@@ -0,0 +1,205 @@
+package poseidon2
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/v2/ff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermute(t *testing.T) {
+	// Permutation of [0, 1, ..., t-1].  The vector for t = 3 is the one of
+	// the HorizenLabs reference implementation (POSEIDON2_BN256_PARAMS).
+	// Neither HorizenLabs nor ICICLE publish BN254 vectors for the other
+	// widths, so they are computed, independently of this package, with the
+	// test vector function of the reference script (poseidon2 in
+	// poseidon2_rust_params.sage), which uses the dense matrices and gives
+	// the vector above for t = 3.
+	expected := map[int][]string{
+		2: {
+			"1d01e56f49579cec72319e145f06f6177f6c5253206e78c2689781452a31878b",
+			"0d189ec589c41b8cffa88cfc523618a055abe8192c70f75aa72fc514560f6c61",
+		},
+		3: {
+			"0bb61d24daca55eebcb1929a82650f328134334da98ea4f847f760054f4a3033",
+			"303b6f7c86d043bfcbcc80214f26a30277a15d3f74ca654992defe7ff8d03570",
+			"1ed25194542b12eef8617361c3ba7c52e660b145994427cc86296242cf766ec8",
+		},
+		4: {
+			"01bd538c2ee014ed5141b29e9ae240bf8db3fe5b9a38629a9647cf8d76c01737",
+			"239b62e7db98aa3a2a8f6a0d2fa1709e7a35959aa6c7034814d9daa90cbac662",
+			"04cbb44c61d928ed06808456bf758cbf0c18d1e15a7b6dbc8245fa7515d5e3cb",
+			"2e11c5cff2a22c64d01304b778d78f6998eff1ab73163a35603f54794c30847a",
+		},
+		8: {
+			"1d1a50bcde871247856df135d56a4ca61af575f1140ed9b1503c77528cf345df",
+			"2d3943cf476ed49fd8a636660d8a76c83b55f07d06bc082005ad7eb1a21791c5",
+			"2fcda2dd846fadfde8104b1d05175dcf3cf8bd698ed8ea3ad2fbcf9c06e00310",
+			"28811ac7e0829171f9d3d81f1c0ff8f34b360d407a16b331a1cb6b5d992de094",
+			"2c07c1817cfccb67c1297935514885c07abad5a0e15477f6c076c0b0fb1ad6f3",
+			"1b6114397199bc44e37437dd3ba1754dff007d3315bfcdcdc14ec27d02452f52",
+			"1431250baf36fb61a07618caee4dd2f500da339a05c553e8f529a3349e617aa2",
+			"0b19bfa00c8f1d505074130e7f8b49a8624b1905e280ceca5ba11099b081b265",
+		},
+		12: {
+			"3014e0ec17029f7e4f5cfe8c7c54fc3df6a5f7539f6aa304b2f3c747a9105618",
+			"2f90753e7aaf46c158cd12346da7dd37c3136353ec51525cabbaaf2b2350f9b2",
+			"2e28bdc8b2c68b09da0cb653ee7e54eca909cf2ae010784554aa3e165b1a105f",
+			"1d6a97ef87dbd3476a848af45beebe6b5d79cb047b37212e3e5839f1e80b397a",
+			"24e23df24b19b75f44218a08d107709d35561bc1b982cfc317d54568cd496519",
+			"185a08e623b85e797844191a1f184f7b8fc486253919eb20f1186a8331757018",
+			"069ed78df853a105c8949dae5b4e81cbe370e8f6e25735a688aa8ff3df9659eb",
+			"284395d79b64123211a4a59b81a90f9cfa8d8314dccde4cef22ec1e31431efd3",
+			"0f24be5a8c95e3504ead0da9e792b77d7056f94461d69b04b33ea5d239f8e444",
+			"022469ccfef0ce5a237518c38dec31fc2804e633b3b365c23a9f703ca31ef393",
+			"1fcdcee218d5a0101bd233d572f184964854d445ca08d2bd6df6ceba5651e322",
+			"0905469a776b7d5a3f18841edb90fa0d8c6de479c2789c042dafefb367ad1a2b",
+		},
+		16: {
+			"0fc2e6b758f493969e1d860f9a44ee3bdffdf796f382aa4ffb16fa4e9bcc333f",
+			"0c118155a0dfeca3f91faf14a350511228ac33743be91249c6e0b3a635a50de4",
+			"1a02b3a6571f22bb6392322d3f9f5de145b4f00bdf483072ce6188c30ba0f83d",
+			"26631df6b2522ecde57413cd680ed590ded356e1c680f865f45be8eb960d1e06",
+			"250ac4dfed40dc37bac9abe46f7bff3a80481d52a157ac80a1e5d39a5ed60e18",
+			"17160980d8e7d9cb31addaf294cf047768bffd9fe433e8903b4ed262ee913f5b",
+			"1d708a9f0995c2e0cd2f55e5dc795126f7191a0eb934ac8172bf54e520361ff6",
+			"20721a18915e96e37e12c9697427f34d6a366787ea94ea65565c36813a0d77a3",
+			"08671a9e58105eed9ac673249dcf22f08f098e3c6eb28f9eaa55d67d755972d0",
+			"01e879484303c6d057128fbcc3a4222c779a62d3666df65d4e0b64c8031d7cc4",
+			"239e2ce87955ebe19aaad000b38725b729f51175ab7d688f15d997edf0e3b7fc",
+			"06be612f42b3ebdbade3fe199338c9118eb6b5fb760bda96e45443f130a8b2de",
+			"11b2c04b4eb9e4844e5ddbb19b56059a815ed5d69405ba51786961235d5f073c",
+			"006da33e2d57616c0ffc855b48d225a1237c3d80fc7e6b6e73b74e162b85c8a8",
+			"0ef50c2615882523c6c73a69b4371332a066b2dc4b9630f186db47e3bfca88c8",
+			"0e2ceb1f8fde5f80be1f41bd239fabdc2f6133a6a98920a55c42891c3a925152",
+		},
+	}
+	for width, exp := range expected {
+		state := make([]ff.Element, width)
+		for i := range state {
+			state[i].SetUint64(uint64(i))
+		}
+		require.NoError(t, Permute(state))
+		for i := range state {
+			assert.Equal(t, exp[i], hex(&state[i]), "width %d, element %d", width, i)
+		}
+	}
+
+	for _, width := range []int{0, 1, 5, 9, 13, 17, 20} {
+		assert.Error(t, Permute(make([]ff.Element, width)))
+	}
+}
+
+func hex(e *ff.Element) string {
+	return fmt.Sprintf("%064x", e.ToBigIntRegular(new(big.Int)))
+}
+
+func TestHash(t *testing.T) {
+	h, err := Hash([]*big.Int{big.NewInt(1), big.NewInt(2)})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"5297208644449048816064511434384511824916970985131888684874823260532015509555",
+		h.String())
+
+	for _, n := range []int{1, 2, 3, 7, 11, 15} {
+		inputs := make([]*big.Int, n)
+		for i := range inputs {
+			inputs[i] = big.NewInt(int64(i + 1))
+		}
+		h, err := Hash(inputs)
+		require.NoError(t, err)
+		state := make([]ff.Element, n+1)
+		for i := range inputs {
+			state[i+1].SetBigInt(inputs[i])
+		}
+		require.NoError(t, Permute(state))
+		assert.Equal(t, state[0].ToBigIntRegular(new(big.Int)), h)
+	}
+
+	_, err = Hash(nil)
+	assert.Error(t, err)
+	_, err = Hash(make([]*big.Int, 4))
+	assert.Error(t, err)
+	_, err = Hash(make([]*big.Int, 12))
+	assert.Error(t, err)
+	_, err = Hash([]*big.Int{ff.Modulus()})
+	assert.Error(t, err)
+}
+
+func TestMatMulExternal(t *testing.T) {
+	// The external matrices published by ICICLE v3.7.0 for BN254
+	// (mds_matrix_4 and mds_matrix_8 in bn254_poseidon2.h), by rows.
+	matrices := map[int][]uint64{
+		4: {
+			5, 7, 1, 3,
+			4, 6, 1, 1,
+			1, 3, 5, 7,
+			1, 1, 4, 6,
+		},
+		8: {
+			10, 14, 2, 6, 5, 7, 1, 3,
+			8, 12, 2, 2, 4, 6, 1, 1,
+			2, 6, 10, 14, 1, 3, 5, 7,
+			2, 2, 8, 12, 1, 1, 4, 6,
+			5, 7, 1, 3, 10, 14, 2, 6,
+			4, 6, 1, 1, 8, 12, 2, 2,
+			1, 3, 5, 7, 2, 6, 10, 14,
+			1, 1, 4, 6, 2, 2, 8, 12,
+		},
+	}
+	for width, m := range matrices {
+		state := make([]ff.Element, width)
+		for i := range state {
+			state[i].SetUint64(uint64(1000 + i*i))
+		}
+		expected := make([]ff.Element, width)
+		var a, mul ff.Element
+		for i := range expected {
+			for j := range state {
+				a.SetUint64(m[i*width+j])
+				mul.Mul(&a, &state[j])
+				expected[i].Add(&expected[i], &mul)
+			}
+		}
+		matMulExternal(state)
+		assert.Equal(t, expected, state, "width %d", width)
+	}
+}
+
+func TestGetConstantsConcurrent(t *testing.T) {
+	// All the goroutines must see the same loaded constants.
+	var wg sync.WaitGroup
+	res := make([]*constants, 16)
+	for i := range res {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i], _ = getConstants(8)
+		}(i)
+	}
+	wg.Wait()
+	for i := range res {
+		assert.Same(t, res[0], res[i])
+	}
+	assert.Len(t, res[0].rc, NROUNDSF*8+NROUNDSP[8])
+	_, ok := getConstants(5)
+	assert.False(t, ok)
+}
+
+func BenchmarkPermute(b *testing.B) {
+	for _, width := range []int{2, 3, 4, 8, 12, 16} {
+		state := make([]ff.Element, width)
+		for i := range state {
+			state[i].SetUint64(uint64(i))
+		}
+		b.Run(fmt.Sprintf("t=%d", width), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = Permute(state)
+			}
+		})
+	}
+}